@@ -0,0 +1,117 @@
+// Package apierror defines typed errors for the HTTP API and the gin
+// middleware that translates them into responses, so handlers can report a
+// failure once (via gin.Context.Error) instead of each building its own
+// JSON response and status code.
+package apierror
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Kind classifies an Error for the purpose of choosing an HTTP status.
+type Kind string
+
+const (
+	KindNotFound         Kind = "not_found"
+	KindValidationFailed Kind = "validation_failed"
+	KindUnauthorized     Kind = "unauthorized"
+	KindForbidden        Kind = "forbidden"
+	KindConflict         Kind = "conflict"
+)
+
+// FieldDetail describes one struct field that failed validation.
+type FieldDetail struct {
+	Field   string      `json:"field"`
+	Tag     string      `json:"tag"`
+	Param   string      `json:"param,omitempty"`
+	Value   interface{} `json:"value,omitempty"`
+	Message string      `json:"message"`
+}
+
+// Error is a typed API failure. The error-handler middleware maps Kind to
+// an HTTP status; anything reaching the middleware that isn't an *Error is
+// treated as an unexpected 500.
+type Error struct {
+	Kind    Kind
+	Message string
+	Details []FieldDetail
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// NotFound reports that the requested resource doesn't exist.
+func NotFound(message string) *Error {
+	return &Error{Kind: KindNotFound, Message: message}
+}
+
+// Unauthorized reports a missing or invalid credential.
+func Unauthorized(message string) *Error {
+	return &Error{Kind: KindUnauthorized, Message: message}
+}
+
+// Forbidden reports a caller who is authenticated but not permitted to act
+// on the resource.
+func Forbidden(message string) *Error {
+	return &Error{Kind: KindForbidden, Message: message}
+}
+
+// Conflict reports that the request can't be applied in the resource's
+// current state.
+func Conflict(message string) *Error {
+	return &Error{Kind: KindConflict, Message: message}
+}
+
+// ValidationFailed reports one or more field-level validation failures.
+func ValidationFailed(details []FieldDetail) *Error {
+	return &Error{Kind: KindValidationFailed, Message: "validation failed", Details: details}
+}
+
+func statusFor(kind Kind) int {
+	switch kind {
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindValidationFailed:
+		return http.StatusBadRequest
+	case KindUnauthorized:
+		return http.StatusUnauthorized
+	case KindForbidden:
+		return http.StatusForbidden
+	case KindConflict:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Middleware renders the last error recorded via gin.Context.Error as a
+// JSON response, mapping typed *Error values to their HTTP status and
+// falling back to 500 for anything else. It must run before routes that
+// call c.Error instead of writing their own response.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		err := c.Errors.Last().Err
+		var apiErr *Error
+		if errors.As(err, &apiErr) {
+			c.JSON(statusFor(apiErr.Kind), gin.H{
+				"error":   string(apiErr.Kind),
+				"message": apiErr.Message,
+				"details": apiErr.Details,
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// Blacklist tracks tokens that have been logged out before their natural
+// expiry, so JWTMiddleware can reject them even though their signature and
+// exp claim are still valid.
+type Blacklist interface {
+	Add(token string, expiresAt time.Time)
+	Contains(token string) bool
+}
+
+// MemoryBlacklist is an in-memory Blacklist. It is safe for concurrent use.
+// Entries are only ever skipped at lookup time, not actively swept; this is
+// fine for the short lifetimes access tokens carry in practice.
+type MemoryBlacklist struct {
+	mu      sync.RWMutex
+	entries map[string]time.Time
+}
+
+// NewMemoryBlacklist returns an empty in-memory blacklist.
+func NewMemoryBlacklist() *MemoryBlacklist {
+	return &MemoryBlacklist{entries: make(map[string]time.Time)}
+}
+
+func (b *MemoryBlacklist) Add(token string, expiresAt time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.entries[token] = expiresAt
+}
+
+func (b *MemoryBlacklist) Contains(token string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	expiresAt, ok := b.entries[token]
+	if !ok {
+		return false
+	}
+	return time.Now().Before(expiresAt)
+}
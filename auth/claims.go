@@ -0,0 +1,10 @@
+package auth
+
+import "github.com/golang-jwt/jwt/v5"
+
+// Claims is the JWT payload issued for an authenticated user.
+type Claims struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+	jwt.RegisteredClaims
+}
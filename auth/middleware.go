@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ContextClaimsKey is the gin.Context key JWTMiddleware stores the parsed
+// Claims under.
+const ContextClaimsKey = "auth_claims"
+
+// JWTMiddleware parses the Authorization: Bearer header, rejecting the
+// request with 401 if the token is missing, malformed, expired, or
+// blacklisted. On success it stores the token's Claims under
+// ContextClaimsKey for downstream handlers.
+func JWTMiddleware(tokens *TokenManager, blacklist Blacklist) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		const prefix = "Bearer "
+		if !strings.HasPrefix(header, prefix) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing or malformed Authorization header"})
+			return
+		}
+		tokenString := strings.TrimPrefix(header, prefix)
+
+		if blacklist != nil && blacklist.Contains(tokenString) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token has been revoked"})
+			return
+		}
+
+		claims, err := tokens.Parse(tokenString)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
+			return
+		}
+
+		c.Set(ContextClaimsKey, claims)
+		c.Next()
+	}
+}
+
+// RequireRole aborts with 403 unless the caller's claims (stored by
+// JWTMiddleware) carry one of the given roles. It must run after
+// JWTMiddleware.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	allowed := make(map[string]bool, len(roles))
+	for _, r := range roles {
+		allowed[r] = true
+	}
+
+	return func(c *gin.Context) {
+		claims, ok := ClaimsFromContext(c)
+		if !ok || !allowed[claims.Role] {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "insufficient role"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// ClaimsFromContext returns the Claims JWTMiddleware stored on c, if any.
+func ClaimsFromContext(c *gin.Context) (*Claims, bool) {
+	value, exists := c.Get(ContextClaimsKey)
+	if !exists {
+		return nil, false
+	}
+	claims, ok := value.(*Claims)
+	return claims, ok
+}
@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newTestRouter(tokens *TokenManager, blacklist Blacklist) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/protected", JWTMiddleware(tokens, blacklist), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return r
+}
+
+func TestJWTMiddlewareRejectsMissingHeader(t *testing.T) {
+	tokens := NewTokenManager("secret", time.Minute, time.Hour)
+	r := newTestRouter(tokens, NewMemoryBlacklist())
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestJWTMiddlewareRejectsExpiredToken(t *testing.T) {
+	tokens := NewTokenManager("secret", -time.Minute, time.Hour)
+	r := newTestRouter(tokens, NewMemoryBlacklist())
+
+	token, err := tokens.GenerateAccessToken("user-1", RoleUser)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestJWTMiddlewareRejectsTamperedToken(t *testing.T) {
+	tokens := NewTokenManager("secret", time.Minute, time.Hour)
+	other := NewTokenManager("different-secret", time.Minute, time.Hour)
+	r := newTestRouter(tokens, NewMemoryBlacklist())
+
+	token, err := other.GenerateAccessToken("user-1", RoleUser)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestJWTMiddlewareRejectsBlacklistedToken(t *testing.T) {
+	tokens := NewTokenManager("secret", time.Minute, time.Hour)
+	blacklist := NewMemoryBlacklist()
+	r := newTestRouter(tokens, blacklist)
+
+	token, err := tokens.GenerateAccessToken("user-1", RoleUser)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+	blacklist.Add(token, time.Now().Add(time.Hour))
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestJWTMiddlewareAcceptsValidToken(t *testing.T) {
+	tokens := NewTokenManager("secret", time.Minute, time.Hour)
+	r := newTestRouter(tokens, NewMemoryBlacklist())
+
+	token, err := tokens.GenerateAccessToken("user-1", RoleUser)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+}
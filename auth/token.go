@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalidToken is returned by TokenManager.Parse for any token that
+// fails signature verification, is expired, or is malformed.
+var ErrInvalidToken = errors.New("auth: invalid token")
+
+// TokenManager issues and parses HS256 JWTs.
+type TokenManager struct {
+	secret     []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// NewTokenManager returns a TokenManager signing with secret and using the
+// given access/refresh token lifetimes.
+func NewTokenManager(secret string, accessTTL, refreshTTL time.Duration) *TokenManager {
+	return &TokenManager{
+		secret:     []byte(secret),
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+// GenerateAccessToken issues a short-lived token carrying the user's ID and
+// role.
+func (m *TokenManager) GenerateAccessToken(userID, role string) (string, error) {
+	return m.generate(userID, role, m.accessTTL)
+}
+
+// GenerateRefreshToken issues a long-lived token carrying the user's ID and
+// an empty role. Parse does not tell it apart from an access token.
+func (m *TokenManager) GenerateRefreshToken(userID string) (string, error) {
+	return m.generate(userID, "", m.refreshTTL)
+}
+
+func (m *TokenManager) generate(userID, role string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID: userID,
+		Role:   role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(m.secret)
+}
+
+// Parse validates tokenString and returns its claims. It fails closed:
+// wrong signing method, bad signature, or expiry all return ErrInvalidToken.
+func (m *TokenManager) Parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrInvalidToken
+		}
+		return m.secret, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
@@ -0,0 +1,97 @@
+// Package auth provides JWT-based authentication and role-based
+// authorization for the task API: user registration/login, token
+// issuance and parsing, a logout blacklist, and the Gin middleware that
+// ties them together.
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Roles recognized by RequireRole.
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// ErrUserExists is returned by UserStore.Create when the username is taken.
+var ErrUserExists = errors.New("auth: username already registered")
+
+// ErrUserNotFound is returned when no user matches the given username or ID.
+var ErrUserNotFound = errors.New("auth: user not found")
+
+// User is an authenticated account. PasswordHash is a bcrypt hash, never
+// the plaintext password.
+type User struct {
+	ID           string
+	Username     string
+	PasswordHash string
+	Role         string
+}
+
+// UserStore is the storage contract for users.
+type UserStore interface {
+	Create(ctx context.Context, user *User) error
+	GetByUsername(ctx context.Context, username string) (*User, error)
+	Get(ctx context.Context, id string) (*User, error)
+}
+
+// MemoryUserStore is an in-memory UserStore. It is safe for concurrent use.
+type MemoryUserStore struct {
+	mu    sync.RWMutex
+	byID  map[string]*User
+	byUsr map[string]string // username -> ID
+}
+
+// NewMemoryUserStore returns an empty in-memory user store.
+func NewMemoryUserStore() *MemoryUserStore {
+	return &MemoryUserStore{
+		byID:  make(map[string]*User),
+		byUsr: make(map[string]string),
+	}
+}
+
+func (s *MemoryUserStore) Create(ctx context.Context, user *User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.byUsr[user.Username]; exists {
+		return ErrUserExists
+	}
+
+	if user.ID == "" {
+		user.ID = uuid.New().String()
+	}
+	stored := *user
+	s.byID[user.ID] = &stored
+	s.byUsr[user.Username] = user.ID
+	return nil
+}
+
+func (s *MemoryUserStore) GetByUsername(ctx context.Context, username string) (*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	id, ok := s.byUsr[username]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	copied := *s.byID[id]
+	return &copied, nil
+}
+
+func (s *MemoryUserStore) Get(ctx context.Context, id string) (*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.byID[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
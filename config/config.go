@@ -0,0 +1,132 @@
+// Package config loads server configuration from a YAML file.
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the top-level application configuration.
+type Config struct {
+	Server        ServerConfig        `yaml:"server"`
+	Database      DatabaseConfig      `yaml:"database"`
+	Queue         QueueConfig         `yaml:"queue"`
+	Auth          AuthConfig          `yaml:"auth"`
+	Observability ObservabilityConfig `yaml:"observability"`
+}
+
+// ServerConfig controls how the HTTP server binds and runs.
+type ServerConfig struct {
+	Port string `yaml:"port"`
+}
+
+// DatabaseConfig selects and configures the task storage backend.
+//
+// Driver is either "memory" (the default, no persistence) or "postgres".
+// The remaining fields are only used when Driver is "postgres".
+type DatabaseConfig struct {
+	Driver          string        `yaml:"driver"`
+	Host            string        `yaml:"host"`
+	Port            int           `yaml:"port"`
+	User            string        `yaml:"user"`
+	Password        string        `yaml:"password"`
+	DBName          string        `yaml:"dbname"`
+	SSLMode         string        `yaml:"sslmode"`
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+}
+
+// QueueConfig controls the optional Asynq/Redis background execution
+// subsystem. When Enabled is false, creating a task never enqueues a job
+// and a --mode=worker process has nothing to consume.
+type QueueConfig struct {
+	Enabled        bool          `yaml:"enabled"`
+	RedisAddr      string        `yaml:"redis_addr"`
+	Queue          string        `yaml:"queue"`
+	Concurrency    int           `yaml:"concurrency"`
+	MaxRetry       int           `yaml:"max_retry"`
+	ProcessTimeout time.Duration `yaml:"process_timeout"`
+}
+
+// AuthConfig controls JWT issuance for the auth subsystem.
+//
+// Secret should be overridden in every real deployment; the Default() value
+// is only fit for local development against the in-memory user store.
+type AuthConfig struct {
+	Secret     string        `yaml:"secret"`
+	AccessTTL  time.Duration `yaml:"access_ttl"`
+	RefreshTTL time.Duration `yaml:"refresh_ttl"`
+}
+
+// ObservabilityConfig toggles the API's metrics, request-ID propagation, and
+// tracing middleware independently. Structured logging itself is always on;
+// these fields control the optional instrumentation layered on top of it.
+type ObservabilityConfig struct {
+	MetricsEnabled   bool   `yaml:"metrics_enabled"`
+	MetricsNamespace string `yaml:"metrics_namespace"`
+	MetricsSubsystem string `yaml:"metrics_subsystem"`
+
+	RequestIDEnabled bool `yaml:"request_id_enabled"`
+
+	TracingEnabled      bool   `yaml:"tracing_enabled"`
+	TracingServiceName  string `yaml:"tracing_service_name"`
+	TracingOTLPEndpoint string `yaml:"tracing_otlp_endpoint"`
+}
+
+// Default returns the configuration used when no config file is supplied:
+// an in-memory repository on port 8080, with the async queue disabled.
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{Port: ":8080"},
+		Database: DatabaseConfig{
+			Driver: "memory",
+		},
+		Queue: QueueConfig{
+			Queue:          "default",
+			Concurrency:    10,
+			MaxRetry:       3,
+			ProcessTimeout: 30 * time.Second,
+		},
+		Auth: AuthConfig{
+			Secret:     "dev-secret-change-me",
+			AccessTTL:  15 * time.Minute,
+			RefreshTTL: 7 * 24 * time.Hour,
+		},
+		Observability: ObservabilityConfig{
+			MetricsEnabled:      true,
+			MetricsNamespace:    "go_demo",
+			MetricsSubsystem:    "api",
+			RequestIDEnabled:    true,
+			TracingEnabled:      false,
+			TracingServiceName:  "go-demo",
+			TracingOTLPEndpoint: "localhost:4317",
+		},
+	}
+}
+
+// Load reads and parses a YAML config file at path. Fields left unset in
+// the file keep their Default() values.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: reading %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("config: parsing %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// DSN builds a libpq-style connection string for the Postgres driver.
+func (d DatabaseConfig) DSN() string {
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		d.Host, d.Port, d.User, d.Password, d.DBName, d.SSLMode)
+}
@@ -0,0 +1,45 @@
+package docs
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// TestSwaggerJSONDeclaresAllRoutes is a smoke test that the generated spec
+// file on disk lists every path main.go declares in an @Router annotation.
+// It fails if docs/swagger.json is missing one of the paths in wantPaths;
+// the list is kept by hand, so a new endpoint is checked once it is added
+// here.
+func TestSwaggerJSONDeclaresAllRoutes(t *testing.T) {
+	data, err := os.ReadFile("swagger.json")
+	if err != nil {
+		t.Fatalf("reading swagger.json: %v", err)
+	}
+
+	var spec struct {
+		Paths map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		t.Fatalf("parsing swagger.json: %v", err)
+	}
+
+	wantPaths := []string{
+		"/api/auth/register",
+		"/api/auth/login",
+		"/api/auth/logout",
+		"/health",
+		"/api/tasks",
+		"/api/tasks/{id}",
+		"/api/tasks/{id}/cancel",
+		"/api/tasks/{id}/events",
+		"/api/stats",
+		"/api/tasks-dead-letter",
+	}
+
+	for _, p := range wantPaths {
+		if _, ok := spec.Paths[p]; !ok {
+			t.Errorf("swagger.json is missing path %q", p)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Package events streams task execution state transitions from the worker
+// process to SSE subscribers in the API process, over Redis Pub/Sub so the
+// two can run as separate processes.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Event is a single state transition or log line for a task's background job.
+type Event struct {
+	TaskID    string    `json:"task_id"`
+	State     string    `json:"state"`
+	Message   string    `json:"message,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Broker publishes and subscribes to task events over Redis Pub/Sub.
+type Broker struct {
+	client *redis.Client
+}
+
+// NewBroker returns a Broker connected to the Redis instance at addr.
+func NewBroker(addr string) *Broker {
+	return &Broker{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+func channel(taskID string) string {
+	return fmt.Sprintf("task-events:%s", taskID)
+}
+
+// Publish broadcasts ev to anyone subscribed to its TaskID.
+func (b *Broker) Publish(ctx context.Context, ev Event) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return b.client.Publish(ctx, channel(ev.TaskID), data).Err()
+}
+
+// Subscribe returns a channel of events for taskID. The returned close
+// function must be called to release the underlying Redis connection.
+func (b *Broker) Subscribe(ctx context.Context, taskID string) (<-chan Event, func() error) {
+	sub := b.client.Subscribe(ctx, channel(taskID))
+	out := make(chan Event)
+
+	go func() {
+		defer close(out)
+		for msg := range sub.Channel() {
+			var ev Event
+			if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+				continue
+			}
+			select {
+			case out <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, sub.Close
+}
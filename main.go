@@ -1,46 +1,54 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
-	"github.com/google/uuid"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"go-demo/apierror"
+	"go-demo/auth"
+	"go-demo/config"
+	_ "go-demo/docs"
+	"go-demo/events"
+	"go-demo/models"
+	"go-demo/observability"
+	"go-demo/patch"
+	"go-demo/repository"
+	"go-demo/service"
+	"go-demo/validation"
+	"go-demo/worker"
 )
 
-// Task represents a task in our system
-type Task struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title" validate:"required,min=1,max=100"`
-	Description string    `json:"description" validate:"required,min=1,max=500"`
-	Priority    string    `json:"priority" validate:"required,oneof=low medium high"`
-	Status      string    `json:"status" validate:"oneof=pending in-progress completed"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-}
+//go:generate swag init
 
-// CreateTaskRequest represents the request payload for creating a task
-type CreateTaskRequest struct {
-	Title       string `json:"title" validate:"required,min=1,max=100"`
-	Description string `json:"description" validate:"required,min=1,max=500"`
-	Priority    string `json:"priority" validate:"required,oneof=low medium high"`
-	Status      string `json:"status,omitempty" validate:"omitempty,oneof=pending in-progress completed"`
-}
-
-// UpdateTaskRequest represents the request payload for updating a task
-type UpdateTaskRequest struct {
-	Title       *string `json:"title,omitempty" validate:"omitempty,min=1,max=100"`
-	Description *string `json:"description,omitempty" validate:"omitempty,min=1,max=500"`
-	Priority    *string `json:"priority,omitempty" validate:"omitempty,oneof=low medium high"`
-	Status      *string `json:"status,omitempty" validate:"omitempty,oneof=pending in-progress completed"`
-}
+// @title        Go Task Management API
+// @version      1.0.0
+// @description  CRUD and async execution API for tasks.
+// @BasePath     /
 
-// ErrorResponse represents an error response
+// ErrorResponse represents an error response. It documents the shape
+// apierror.Middleware renders; most handlers call gin.Context.Error with a
+// typed *apierror.Error instead of constructing it. The 415 in patchTask and
+// the 503s in taskEvents and deadLetterTasks are still built directly.
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message,omitempty"`
+	Error   string                 `json:"error"`
+	Message string                 `json:"message,omitempty"`
+	Details []apierror.FieldDetail `json:"details,omitempty"`
 }
 
 // SuccessResponse represents a success response
@@ -49,31 +57,215 @@ type SuccessResponse struct {
 	Data    interface{} `json:"data,omitempty"`
 }
 
-// In-memory storage (like our other examples)
-var tasks = make(map[string]*Task)
-var validator_instance = validator.New()
+// Server wires HTTP handlers to the task service. Handlers are methods on
+// Server instead of free functions so they no longer depend on package
+// globals for storage or validation.
+type Server struct {
+	tasks      *service.TaskService
+	validator  *validator.Validate
+	translator *ut.UniversalTranslator
+	events     *events.Broker
+	deadLetter *worker.Inspector
+	users      auth.UserStore
+	tokens     *auth.TokenManager
+	blacklist  auth.Blacklist
+	metrics    *observability.Metrics
+}
 
-// Initialize with sample data
-func init() {
-	sampleTask := &Task{
-		ID:          uuid.New().String(),
-		Title:       "Sample Task",
-		Description: "This is a sample task",
-		Priority:    "high",
-		Status:      "pending",
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+// NewServer builds a Server backed by the given task service. events and
+// deadLetter are optional (nil disables /events and the dead-letter
+// endpoint, returning 503) and are only wired up when the async queue is
+// enabled. metrics is optional (nil disables task-operation and
+// validation-failure counters when observability.metrics_enabled is false).
+func NewServer(tasks *service.TaskService, broker *events.Broker, deadLetter *worker.Inspector, users auth.UserStore, tokens *auth.TokenManager, blacklist auth.Blacklist, metrics *observability.Metrics) (*Server, error) {
+	v, translator, err := validation.New()
+	if err != nil {
+		return nil, fmt.Errorf("building validator: %w", err)
+	}
+
+	return &Server{
+		tasks:      tasks,
+		validator:  v,
+		translator: translator,
+		events:     broker,
+		deadLetter: deadLetter,
+		users:      users,
+		tokens:     tokens,
+		blacklist:  blacklist,
+		metrics:    metrics,
+	}, nil
+}
+
+// validateStruct runs struct tag validation using the server's validator
+// instance, translating any failure into field-level details using the
+// request's Accept-Language header.
+func (s *Server) validateStruct(c *gin.Context, v interface{}) *apierror.Error {
+	if err := s.validator.Struct(v); err != nil {
+		trans := validation.Translator(s.translator, c.GetHeader("Accept-Language"))
+		s.recordValidationFailure(c)
+		return apierror.ValidationFailed(validation.Details(err, trans))
+	}
+	return nil
+}
+
+// recordValidationFailure increments the validation-failure counter for the
+// route being served. It is a no-op when metrics are disabled.
+func (s *Server) recordValidationFailure(c *gin.Context) {
+	if s.metrics == nil {
+		return
+	}
+	route := c.FullPath()
+	if route == "" {
+		route = c.Request.URL.Path
 	}
-	tasks[sampleTask.ID] = sampleTask
+	s.metrics.RecordValidationFailure(route)
 }
 
-// Validation helper function
-func validateStruct(s interface{}) error {
-	return validator_instance.Struct(s)
+// recordTaskOp increments the task-operation counter for operation/outcome.
+// It is a no-op when metrics are disabled.
+func (s *Server) recordTaskOp(operation, outcome string) {
+	if s.metrics == nil {
+		return
+	}
+	s.metrics.RecordTaskOp(operation, outcome)
 }
 
-// Health check endpoint
-func healthCheck(c *gin.Context) {
+// bindJSONError wraps a JSON-decoding failure as a validation error so
+// malformed bodies and failed struct tags render the same response shape.
+func bindJSONError(err error) *apierror.Error {
+	return apierror.ValidationFailed([]apierror.FieldDetail{{Message: err.Error()}})
+}
+
+// register creates a new user account.
+//
+// @Summary      Register an account
+// @Description  Creates a user with the "user" role and returns a token pair.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        credentials  body      models.RegisterRequest  true  "New account credentials"
+// @Success      201          {object}  models.AuthResponse
+// @Failure      400          {object}  ErrorResponse
+// @Failure      409          {object}  ErrorResponse
+// @Router       /api/auth/register [post]
+func (s *Server) register(c *gin.Context) {
+	var req models.RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindJSONError(err))
+		return
+	}
+	if verr := s.validateStruct(c, req); verr != nil {
+		c.Error(verr)
+		return
+	}
+
+	hash, err := auth.HashPassword(req.Password)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	user := &auth.User{Username: req.Username, PasswordHash: hash, Role: auth.RoleUser}
+	if err := s.users.Create(c.Request.Context(), user); err != nil {
+		if err == auth.ErrUserExists {
+			c.Error(apierror.Conflict(err.Error()))
+			return
+		}
+		c.Error(err)
+		return
+	}
+
+	tokens, err := s.issueTokens(user)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, tokens)
+}
+
+// login authenticates a user and issues a token pair.
+//
+// @Summary      Log in
+// @Description  Verifies credentials and returns an access/refresh token pair.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        credentials  body      models.LoginRequest  true  "Account credentials"
+// @Success      200          {object}  models.AuthResponse
+// @Failure      400          {object}  ErrorResponse
+// @Failure      401          {object}  ErrorResponse
+// @Router       /api/auth/login [post]
+func (s *Server) login(c *gin.Context) {
+	var req models.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindJSONError(err))
+		return
+	}
+	if verr := s.validateStruct(c, req); verr != nil {
+		c.Error(verr)
+		return
+	}
+
+	user, err := s.users.GetByUsername(c.Request.Context(), req.Username)
+	if err != nil || !auth.CheckPassword(user.PasswordHash, req.Password) {
+		c.Error(apierror.Unauthorized("invalid credentials"))
+		return
+	}
+
+	tokens, err := s.issueTokens(user)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, tokens)
+}
+
+// logout blacklists the caller's access token so it can no longer be used,
+// even though it hasn't expired yet.
+//
+// @Summary      Log out
+// @Description  Revokes the presented access token.
+// @Tags         auth
+// @Produce      json
+// @Success      204  "no content"
+// @Failure      401  {object}  ErrorResponse
+// @Router       /api/auth/logout [post]
+func (s *Server) logout(c *gin.Context) {
+	header := c.GetHeader("Authorization")
+	tokenString := strings.TrimPrefix(header, "Bearer ")
+
+	claims, err := s.tokens.Parse(tokenString)
+	if err != nil {
+		c.Error(apierror.Unauthorized("invalid token"))
+		return
+	}
+
+	s.blacklist.Add(tokenString, claims.ExpiresAt.Time)
+	c.Status(http.StatusNoContent)
+}
+
+// issueTokens mints an access/refresh token pair for user.
+func (s *Server) issueTokens(user *auth.User) (models.AuthResponse, error) {
+	access, err := s.tokens.GenerateAccessToken(user.ID, user.Role)
+	if err != nil {
+		return models.AuthResponse{}, err
+	}
+	refresh, err := s.tokens.GenerateRefreshToken(user.ID)
+	if err != nil {
+		return models.AuthResponse{}, err
+	}
+	return models.AuthResponse{AccessToken: access, RefreshToken: refresh, TokenType: "Bearer"}, nil
+}
+
+// healthCheck reports service liveness.
+//
+// @Summary      Health check
+// @Description  Returns service status, version, and server time.
+// @Tags         health
+// @Produce      json
+// @Success      200  {object}  map[string]interface{}
+// @Router       /health [get]
+func (s *Server) healthCheck(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
 		"status":    "healthy",
 		"timestamp": time.Now(),
@@ -81,256 +273,716 @@ func healthCheck(c *gin.Context) {
 	})
 }
 
-// Get all tasks
-func getTasks(c *gin.Context) {
-	taskList := make([]*Task, 0, len(tasks))
-	for _, task := range tasks {
-		taskList = append(taskList, task)
+// getTasks lists the caller's tasks, or every task for an admin.
+//
+// @Summary      List tasks
+// @Description  Returns the caller's own tasks; admins get every task stored.
+// @Tags         tasks
+// @Produce      json
+// @Success      200  {object}  map[string]interface{}
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/tasks [get]
+func (s *Server) getTasks(c *gin.Context) {
+	taskList, err := s.tasks.List(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	claims, ok := auth.ClaimsFromContext(c)
+	if !ok {
+		c.Error(apierror.Unauthorized("missing credentials"))
+		return
+	}
+	if claims.Role != auth.RoleAdmin {
+		owned := taskList[:0]
+		for _, t := range taskList {
+			if t.OwnerID == claims.UserID {
+				owned = append(owned, t)
+			}
+		}
+		taskList = owned
 	}
-	
+
 	c.JSON(http.StatusOK, gin.H{
 		"tasks": taskList,
 		"count": len(taskList),
 	})
 }
 
-// Get a single task by ID
-func getTask(c *gin.Context) {
+// getTask fetches one task by ID.
+//
+// @Summary      Get a task
+// @Description  Returns a single task by its ID.
+// @Tags         tasks
+// @Produce      json
+// @Param        id   path      string  true  "Task ID"
+// @Success      200  {object}  models.Task
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/tasks/{id} [get]
+func (s *Server) getTask(c *gin.Context) {
 	id := c.Param("id")
-	
-	task, exists := tasks[id]
-	if !exists {
-		c.JSON(http.StatusNotFound, ErrorResponse{
-			Error:   "Task not found",
-			Message: "Task with ID " + id + " does not exist",
-		})
+
+	task, err := s.tasks.Get(c.Request.Context(), id)
+	if err != nil {
+		s.handleRepoError(c, id, err)
+		return
+	}
+	if !s.authorizeTask(c, task) {
 		return
 	}
-	
+
 	c.JSON(http.StatusOK, task)
 }
 
-// Create a new task
-func createTask(c *gin.Context) {
-	var req CreateTaskRequest
-	
+// authorizeTask reports whether the authenticated caller (an admin, or the
+// task's owner) may act on task. Otherwise it records a 403/401
+// apierror.Error for the centralized error-handler middleware and returns
+// false.
+func (s *Server) authorizeTask(c *gin.Context, task *models.Task) bool {
+	claims, ok := auth.ClaimsFromContext(c)
+	if !ok {
+		c.Error(apierror.Unauthorized("missing credentials"))
+		return false
+	}
+	if claims.Role == auth.RoleAdmin || claims.UserID == task.OwnerID {
+		return true
+	}
+	c.Error(apierror.Forbidden("you do not own this task"))
+	return false
+}
+
+// createTask creates a new task.
+//
+// @Summary      Create a task
+// @Description  Creates a task, defaulting status to "pending" when omitted.
+// @Tags         tasks
+// @Accept       json
+// @Produce      json
+// @Param        task  body      models.CreateTaskRequest  true  "Task to create"
+// @Success      201   {object}  models.Task
+// @Failure      400   {object}  ErrorResponse
+// @Router       /api/tasks [post]
+func (s *Server) createTask(c *gin.Context) {
+	var req models.CreateTaskRequest
+
 	// Bind JSON to struct
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Invalid JSON",
-			Message: err.Error(),
-		})
+		c.Error(bindJSONError(err))
 		return
 	}
-	
+
 	// Validate the request
-	if err := validateStruct(req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Validation failed",
-			Message: err.Error(),
-		})
+	if verr := s.validateStruct(c, req); verr != nil {
+		c.Error(verr)
 		return
 	}
-	
-	// Set default status if not provided
-	status := req.Status
-	if status == "" {
-		status = "pending"
-	}
-	
-	// Create new task
-	task := &Task{
-		ID:          uuid.New().String(),
-		Title:       req.Title,
-		Description: req.Description,
-		Priority:    req.Priority,
-		Status:      status,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
-	}
-	
-	// Store the task
-	tasks[task.ID] = task
-	
+
+	claims, ok := auth.ClaimsFromContext(c)
+	if !ok {
+		c.Error(apierror.Unauthorized("missing credentials"))
+		return
+	}
+
+	task, err := s.tasks.Create(c.Request.Context(), req, claims.UserID)
+	if err != nil {
+		s.recordTaskOp("create", "error")
+		c.Error(err)
+		return
+	}
+	s.recordTaskOp("create", "success")
+
 	c.JSON(http.StatusCreated, task)
 }
 
-// Update an existing task
-func updateTask(c *gin.Context) {
+// updateTask replaces an existing task wholesale: every field in the
+// request body overwrites the stored value, unlike PATCH's partial merge.
+//
+// @Summary      Replace a task
+// @Description  Full-replace update; every field is required, even ones left unchanged.
+// @Tags         tasks
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                     true  "Task ID"
+// @Param        task  body      models.ReplaceTaskRequest  true  "Full task representation"
+// @Success      200   {object}  models.Task
+// @Failure      400   {object}  ErrorResponse
+// @Failure      404   {object}  ErrorResponse
+// @Router       /api/tasks/{id} [put]
+func (s *Server) updateTask(c *gin.Context) {
 	id := c.Param("id")
-	
-	// Check if task exists
-	task, exists := tasks[id]
-	if !exists {
-		c.JSON(http.StatusNotFound, ErrorResponse{
-			Error:   "Task not found",
-			Message: "Task with ID " + id + " does not exist",
-		})
-		return
-	}
-	
-	var req UpdateTaskRequest
-	
+
+	var req models.ReplaceTaskRequest
+
 	// Bind JSON to struct
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Invalid JSON",
-			Message: err.Error(),
-		})
+		c.Error(bindJSONError(err))
 		return
 	}
-	
+
 	// Validate the request
-	if err := validateStruct(req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Validation failed",
-			Message: err.Error(),
-		})
+	if verr := s.validateStruct(c, req); verr != nil {
+		c.Error(verr)
 		return
 	}
-	
-	// Update fields if provided (partial update)
-	if req.Title != nil {
-		task.Title = *req.Title
+
+	existing, err := s.tasks.Get(c.Request.Context(), id)
+	if err != nil {
+		s.handleRepoError(c, id, err)
+		return
 	}
-	if req.Description != nil {
-		task.Description = *req.Description
+	if !s.authorizeTask(c, existing) {
+		return
 	}
-	if req.Priority != nil {
-		task.Priority = *req.Priority
+
+	if verr := s.validatePriorityTransition(c, existing.Priority, req.Priority); verr != nil {
+		c.Error(verr)
+		return
 	}
-	if req.Status != nil {
-		task.Status = *req.Status
+
+	task, err := s.tasks.Replace(c.Request.Context(), id, req)
+	if err != nil {
+		s.recordTaskOp("update", "error")
+		s.handleRepoError(c, id, err)
+		return
 	}
-	
-	// Update timestamp
-	task.UpdatedAt = time.Now()
-	
+	s.recordTaskOp("update", "success")
+
 	c.JSON(http.StatusOK, task)
 }
 
-// Delete a task
-func deleteTask(c *gin.Context) {
+// validatePriorityTransition rejects a priority update that would downgrade
+// the task, using the registered priority_order validator to compare the
+// task's current priority against the requested one.
+func (s *Server) validatePriorityTransition(c *gin.Context, current, next string) *apierror.Error {
+	transition := struct {
+		Current  string `validate:"omitempty,oneof=low medium high"`
+		Priority string `validate:"omitempty,priority_order=Current"`
+	}{Current: current, Priority: next}
+
+	return s.validateStruct(c, transition)
+}
+
+// patchTask applies a merge-patch or JSON-patch document to an existing task.
+//
+// @Summary      Patch a task
+// @Description  Applies RFC 7396 (application/merge-patch+json) or RFC 6902 (application/json-patch+json) semantics, selected by Content-Type.
+// @Tags         tasks
+// @Accept       json
+// @Produce      json
+// @Param        id    path  string  true  "Task ID"
+// @Success      202   {object}  models.Task
+// @Failure      400   {object}  ErrorResponse
+// @Failure      404   {object}  ErrorResponse
+// @Failure      415   {object}  ErrorResponse
+// @Router       /api/tasks/{id} [patch]
+func (s *Server) patchTask(c *gin.Context) {
 	id := c.Param("id")
-	
-	// Check if task exists
-	_, exists := tasks[id]
-	if !exists {
-		c.JSON(http.StatusNotFound, ErrorResponse{
-			Error:   "Task not found",
-			Message: "Task with ID " + id + " does not exist",
+
+	task, err := s.tasks.Get(c.Request.Context(), id)
+	if err != nil {
+		s.handleRepoError(c, id, err)
+		return
+	}
+	if !s.authorizeTask(c, task) {
+		return
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
+		c.Error(bindJSONError(err))
+		return
+	}
+
+	original, err := json.Marshal(task)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	contentType := strings.ToLower(c.ContentType())
+
+	var patched []byte
+	switch contentType {
+	case "application/json-patch+json":
+		var ops []patch.Operation
+		if err := json.Unmarshal(body, &ops); err != nil {
+			c.Error(bindJSONError(err))
+			return
+		}
+		patched, err = patch.ApplyJSONPatch(original, ops)
+	case "application/merge-patch+json", "application/json", "":
+		patched, err = patch.ApplyMergePatch(original, body)
+	default:
+		c.JSON(http.StatusUnsupportedMediaType, ErrorResponse{
+			Error:   "Unsupported Content-Type",
+			Message: "use application/merge-patch+json or application/json-patch+json",
 		})
 		return
 	}
-	
-	// Delete the task
-	delete(tasks, id)
-	
-	c.JSON(http.StatusNoContent, nil)
+	if err != nil {
+		c.Error(bindJSONError(err))
+		return
+	}
+
+	var updated models.Task
+	if err := json.Unmarshal(patched, &updated); err != nil {
+		c.Error(bindJSONError(err))
+		return
+	}
+
+	if verr := s.validateStruct(c, updated); verr != nil {
+		c.Error(verr)
+		return
+	}
+
+	if verr := s.validatePriorityTransition(c, task.Priority, updated.Priority); verr != nil {
+		c.Error(verr)
+		return
+	}
+
+	saved, err := s.tasks.ApplyPatch(c.Request.Context(), id, &updated)
+	if err != nil {
+		s.recordTaskOp("patch", "error")
+		s.handleRepoError(c, id, err)
+		return
+	}
+	s.recordTaskOp("patch", "success")
+
+	c.JSON(http.StatusAccepted, saved)
 }
 
-// Get tasks statistics
-func getTaskStats(c *gin.Context) {
-	stats := map[string]int{
-		"total":       len(tasks),
-		"pending":     0,
-		"in-progress": 0,
-		"completed":   0,
-		"low":         0,
-		"medium":      0,
-		"high":        0,
+// deleteTask removes a task.
+//
+// @Summary      Delete a task
+// @Description  Deletes a task by ID.
+// @Tags         tasks
+// @Param        id   path  string  true  "Task ID"
+// @Success      204  "no content"
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/tasks/{id} [delete]
+func (s *Server) deleteTask(c *gin.Context) {
+	id := c.Param("id")
+
+	existing, err := s.tasks.Get(c.Request.Context(), id)
+	if err != nil {
+		s.handleRepoError(c, id, err)
+		return
+	}
+	if !s.authorizeTask(c, existing) {
+		return
+	}
+
+	if err := s.tasks.Delete(c.Request.Context(), id); err != nil {
+		s.recordTaskOp("delete", "error")
+		s.handleRepoError(c, id, err)
+		return
 	}
-	
-	for _, task := range tasks {
-		stats[task.Status]++
-		stats[task.Priority]++
+	s.recordTaskOp("delete", "success")
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// getTaskStats summarizes tasks by status and priority.
+//
+// @Summary      Task statistics
+// @Description  Returns task counts grouped by status and priority.
+// @Tags         tasks
+// @Produce      json
+// @Success      200  {object}  map[string]interface{}
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/stats [get]
+func (s *Server) getTaskStats(c *gin.Context) {
+	stats, err := s.tasks.Stats(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
 	}
-	
+
 	c.JSON(http.StatusOK, gin.H{
 		"statistics": stats,
 		"timestamp":  time.Now(),
 	})
 }
 
+// cancelTask signals the background job processing a task to stop.
+//
+// @Summary      Cancel a task's background job
+// @Description  Cancels the in-flight Asynq job processing this task, if any.
+// @Tags         tasks
+// @Produce      json
+// @Param        id   path  string  true  "Task ID"
+// @Success      202  {object}  models.Task
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Router       /api/tasks/{id}/cancel [post]
+func (s *Server) cancelTask(c *gin.Context) {
+	id := c.Param("id")
+
+	existing, err := s.tasks.Get(c.Request.Context(), id)
+	if err != nil {
+		s.handleRepoError(c, id, err)
+		return
+	}
+	if !s.authorizeTask(c, existing) {
+		return
+	}
+
+	task, err := s.tasks.Cancel(c.Request.Context(), id)
+	if err != nil {
+		s.recordTaskOp("cancel", "error")
+		if err == repository.ErrNotFound {
+			s.handleRepoError(c, id, err)
+			return
+		}
+		c.Error(apierror.Conflict(err.Error()))
+		return
+	}
+	s.recordTaskOp("cancel", "success")
+
+	c.JSON(http.StatusAccepted, task)
+}
+
+// taskEvents streams a task's background job state transitions.
+//
+// @Summary      Stream a task's execution events
+// @Description  Server-Sent Events stream of execution state transitions until the job reaches a terminal state or the client disconnects.
+// @Tags         tasks
+// @Produce      text/event-stream
+// @Param        id   path  string  true  "Task ID"
+// @Success      200  {string}  string  "text/event-stream"
+// @Failure      404  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /api/tasks/{id}/events [get]
+func (s *Server) taskEvents(c *gin.Context) {
+	id := c.Param("id")
+
+	if s.events == nil {
+		c.JSON(http.StatusServiceUnavailable, ErrorResponse{
+			Error:   "Async execution is not configured",
+			Message: "enable queue.enabled in config.yaml to stream task events",
+		})
+		return
+	}
+
+	existing, err := s.tasks.Get(c.Request.Context(), id)
+	if err != nil {
+		s.handleRepoError(c, id, err)
+		return
+	}
+	if !s.authorizeTask(c, existing) {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	ch, closeSub := s.events.Subscribe(ctx, id)
+	defer closeSub()
+
+	c.Stream(func(w io.Writer) bool {
+		ev, ok := <-ch
+		if !ok {
+			return false
+		}
+		c.SSEvent(ev.State, ev)
+		switch ev.State {
+		case "succeeded", "failed", "cancelled":
+			return false
+		default:
+			return true
+		}
+	})
+}
+
+// deadLetterTasks lists jobs that exhausted their retries.
+//
+// @Summary      List dead-lettered jobs
+// @Description  Returns jobs that exhausted their retries (the Asynq archived queue).
+// @Tags         tasks
+// @Produce      json
+// @Success      200  {object}  map[string]interface{}
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /api/tasks-dead-letter [get]
+func (s *Server) deadLetterTasks(c *gin.Context) {
+	if s.deadLetter == nil {
+		c.JSON(http.StatusServiceUnavailable, ErrorResponse{
+			Error:   "Async execution is not configured",
+			Message: "enable queue.enabled in config.yaml to inspect the dead letter queue",
+		})
+		return
+	}
+
+	tasks, err := s.deadLetter.ListDeadLetter()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"dead_letter": tasks,
+		"count":       len(tasks),
+	})
+}
+
+// handleRepoError records a typed apierror.Error for a repository failure
+// so the centralized error-handler middleware can translate it to the
+// right HTTP status.
+func (s *Server) handleRepoError(c *gin.Context, id string, err error) {
+	if err == repository.ErrNotFound {
+		c.Error(apierror.NotFound("Task with ID " + id + " does not exist"))
+		return
+	}
+	c.Error(err)
+}
+
 // CORS middleware
 func corsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
 		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
-		
+
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(http.StatusNoContent)
 			return
 		}
-		
+
 		c.Next()
 	}
 }
 
-// Logging middleware
-func loggingMiddleware() gin.HandlerFunc {
-	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
-		return fmt.Sprintf("%s - [%s] \"%s %s %s %d %s \"%s\" %s\"\n",
-			param.ClientIP,
-			param.TimeStamp.Format(time.RFC1123),
-			param.Method,
-			param.Path,
-			param.Request.Proto,
-			param.StatusCode,
-			param.Latency,
-			param.Request.UserAgent(),
-			param.ErrorMessage,
-		)
-	})
+// newRepository selects a TaskRepository implementation based on cfg.Database.Driver.
+// The in-memory driver is seeded with one sample task so a fresh checkout
+// has something to look at without a config file or database.
+func newRepository(cfg *config.Config) (repository.TaskRepository, error) {
+	switch cfg.Database.Driver {
+	case "", "memory":
+		repo := repository.NewMemoryRepository()
+		seedSampleTask(repo)
+		return repo, nil
+	case "postgres":
+		return repository.NewPostgresRepository(cfg.Database)
+	default:
+		return nil, fmt.Errorf("unknown database driver %q", cfg.Database.Driver)
+	}
 }
 
-func main() {
+func seedSampleTask(repo repository.TaskRepository) {
+	svc := service.New(repo)
+	_, err := svc.Create(context.Background(), models.CreateTaskRequest{
+		Title:       "Sample Task",
+		Description: "This is a sample task",
+		Priority:    "high",
+		Status:      "pending",
+	}, "system")
+	if err != nil {
+		log.Printf("failed to seed sample task: %v", err)
+	}
+}
+
+// buildAuthDeps assembles the user store, token manager, and token
+// blacklist the auth subsystem needs. The user store and blacklist are
+// in-memory today; swapping in Redis/Postgres-backed implementations only
+// requires changing this function, mirroring how newRepository selects a
+// TaskRepository.
+func buildAuthDeps(cfg *config.Config) (auth.UserStore, *auth.TokenManager, auth.Blacklist) {
+	users := auth.NewMemoryUserStore()
+	tokens := auth.NewTokenManager(cfg.Auth.Secret, cfg.Auth.AccessTTL, cfg.Auth.RefreshTTL)
+	blacklist := auth.NewMemoryBlacklist()
+	return users, tokens, blacklist
+}
+
+// buildObservability assembles the structured logger, optional Prometheus
+// metrics, and tracer the API middleware needs, based on cfg.Observability.
+// Structured logging is always on; metrics is nil when disabled, and the
+// tracer falls back to the OpenTelemetry no-op implementation when tracing
+// is disabled or the OTLP exporter can't be built, so callers never need a
+// nil check on it.
+func buildObservability(cfg *config.Config) (*zap.Logger, *observability.Metrics, trace.Tracer, error) {
+	logger, err := observability.NewLogger()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("building logger: %w", err)
+	}
+
+	var metrics *observability.Metrics
+	if cfg.Observability.MetricsEnabled {
+		metrics = observability.NewMetrics(cfg.Observability.MetricsNamespace, cfg.Observability.MetricsSubsystem)
+	}
+
+	tracer := otel.Tracer(cfg.Observability.TracingServiceName)
+	if cfg.Observability.TracingEnabled {
+		provider, err := observability.NewTracerProvider(context.Background(), cfg.Observability.TracingOTLPEndpoint, cfg.Observability.TracingServiceName)
+		if err != nil {
+			log.Printf("observability: tracing disabled, building OTLP exporter: %v", err)
+		} else {
+			tracer = provider.Tracer(cfg.Observability.TracingServiceName)
+		}
+	}
+
+	return logger, metrics, tracer, nil
+}
+
+// buildTaskService assembles the TaskService with async execution wired in
+// when the queue is enabled, plus the events broker and dead-letter
+// inspector the HTTP layer needs alongside it. The job-submitting enqueuer
+// is held only by the returned TaskService; the inspector serves both the
+// service (for cancelling) and the dead-letter endpoint.
+func buildTaskService(cfg *config.Config, repo repository.TaskRepository) (*service.TaskService, *events.Broker, *worker.Inspector) {
+	if !cfg.Queue.Enabled {
+		return service.New(repo), nil, nil
+	}
+
+	enqueuer := worker.NewEnqueuer(cfg.Queue)
+	inspector := worker.NewInspector(cfg.Queue)
+	broker := events.NewBroker(cfg.Queue.RedisAddr)
+
+	svc := service.New(repo, service.WithAsyncExecution(enqueuer, inspector))
+	return svc, broker, inspector
+}
+
+// runWorker starts the Asynq consumer that processes "task:process" jobs.
+// It blocks until the worker shuts down.
+func runWorker(cfg *config.Config, repo repository.TaskRepository) error {
+	broker := events.NewBroker(cfg.Queue.RedisAddr)
+	proc := worker.NewProcessor(service.New(repo), broker)
+	return worker.RunServer(cfg.Queue, proc)
+}
+
+// runAPIServer builds the gin router and blocks serving HTTP.
+func runAPIServer(cfg *config.Config, repo repository.TaskRepository) error {
+	logger, metrics, tracer, err := buildObservability(cfg)
+	if err != nil {
+		return err
+	}
+	if cfg.Observability.TracingEnabled {
+		repo = repository.NewTracedRepository(repo, tracer)
+	}
+
+	svc, broker, inspector := buildTaskService(cfg, repo)
+	users, tokens, blacklist := buildAuthDeps(cfg)
+	server, err := NewServer(svc, broker, inspector, users, tokens, blacklist, metrics)
+	if err != nil {
+		return err
+	}
+
 	// Create Gin router
 	r := gin.New()
-	
-	// Add middleware
-	r.Use(loggingMiddleware())
+
+	// Add middleware. Order matters: RequestID and Tracing must run before
+	// Logging so it can read the request/trace IDs they attach to the
+	// context.
+	if cfg.Observability.RequestIDEnabled {
+		r.Use(observability.RequestID())
+	}
+	if cfg.Observability.TracingEnabled {
+		r.Use(observability.Tracing(tracer))
+	}
+	r.Use(observability.Logging(logger))
+	if metrics != nil {
+		r.Use(metrics.Middleware())
+	}
 	r.Use(corsMiddleware())
 	r.Use(gin.Recovery())
-	
+	r.Use(apierror.Middleware())
+
 	// Welcome endpoint
 	r.GET("/", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{
 			"message": "Welcome to Go Task Management API",
 			"version": "1.0.0",
-			"docs":    "Visit /health for health check",
+			"docs":    "Visit /swagger/index.html for the interactive API contract",
 		})
 	})
-	
+
 	// Health check
-	r.GET("/health", healthCheck)
-	
+	r.GET("/health", server.healthCheck)
+
+	if metrics != nil {
+		r.GET("/metrics", metrics.Handler())
+	}
+
+	// Swagger UI, backed by docs/swagger.json generated via `swag init`.
+	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+
 	// API routes group
 	api := r.Group("/api")
 	{
-		// Task routes
+		// Auth routes
+		authGroup := api.Group("/auth")
+		{
+			authGroup.POST("/register", server.register)                                    // POST /api/auth/register
+			authGroup.POST("/login", server.login)                                          // POST /api/auth/login
+			authGroup.POST("/logout", auth.JWTMiddleware(tokens, blacklist), server.logout) // POST /api/auth/logout
+		}
+
+		// Task routes, scoped to the authenticated caller
 		tasks := api.Group("/tasks")
+		tasks.Use(auth.JWTMiddleware(tokens, blacklist))
 		{
-			tasks.GET("", getTasks)           // GET /api/tasks
-			tasks.POST("", createTask)        // POST /api/tasks
-			tasks.GET("/:id", getTask)        // GET /api/tasks/:id
-			tasks.PUT("/:id", updateTask)     // PUT /api/tasks/:id
-			tasks.DELETE("/:id", deleteTask)  // DELETE /api/tasks/:id
+			tasks.GET("", server.getTasks)               // GET /api/tasks
+			tasks.POST("", server.createTask)            // POST /api/tasks
+			tasks.GET("/:id", server.getTask)            // GET /api/tasks/:id
+			tasks.PUT("/:id", server.updateTask)         // PUT /api/tasks/:id
+			tasks.PATCH("/:id", server.patchTask)        // PATCH /api/tasks/:id
+			tasks.DELETE("/:id", server.deleteTask)      // DELETE /api/tasks/:id
+			tasks.POST("/:id/cancel", server.cancelTask) // POST /api/tasks/:id/cancel
+			tasks.GET("/:id/events", server.taskEvents)  // GET /api/tasks/:id/events (SSE)
+		}
+
+		// Statistics and dead-letter inspection expose data across every
+		// user's tasks, so they require an authenticated admin rather than
+		// just the per-owner scoping the /tasks routes use.
+		admin := api.Group("")
+		admin.Use(auth.JWTMiddleware(tokens, blacklist), auth.RequireRole(auth.RoleAdmin))
+		{
+			admin.GET("/stats", server.getTaskStats)                // GET /api/stats
+			admin.GET("/tasks-dead-letter", server.deadLetterTasks) // GET /api/tasks-dead-letter
 		}
-		
-		// Statistics route
-		api.GET("/stats", getTaskStats)  // GET /api/stats
 	}
-	
+
 	// Start server
-	port := ":8080"
+	port := cfg.Server.Port
 	fmt.Printf("🚀 Server starting on http://localhost%s\n", port)
 	fmt.Printf("📚 API endpoints available at http://localhost%s/api/tasks\n", port)
 	fmt.Printf("💚 Health check at http://localhost%s/health\n", port)
-	
-	// This blocks until the server stops
-	if err := r.Run(port); err != nil {
-		panic("Failed to start server: " + err.Error())
+
+	return r.Run(port)
+}
+
+func main() {
+	mode := flag.String("mode", "server", "which process to run: server, worker, or both")
+	flag.Parse()
+
+	cfg, err := config.Load("config.yaml")
+	if err != nil {
+		log.Printf("config: %v (falling back to in-memory defaults)", err)
+		cfg = config.Default()
 	}
-}
\ No newline at end of file
+
+	repo, err := newRepository(cfg)
+	if err != nil {
+		panic("Failed to initialize storage: " + err.Error())
+	}
+
+	switch *mode {
+	case "server":
+		if err := runAPIServer(cfg, repo); err != nil {
+			panic("Failed to start server: " + err.Error())
+		}
+	case "worker":
+		if err := runWorker(cfg, repo); err != nil {
+			panic("Failed to start worker: " + err.Error())
+		}
+	case "both":
+		errCh := make(chan error, 2)
+		go func() { errCh <- runWorker(cfg, repo) }()
+		go func() { errCh <- runAPIServer(cfg, repo) }()
+		if err := <-errCh; err != nil {
+			panic("Failed to run: " + err.Error())
+		}
+	default:
+		panic(fmt.Sprintf("unknown --mode %q (want server, worker, or both)", *mode))
+	}
+}
@@ -0,0 +1,83 @@
+// Package models holds the data types shared across the storage, service,
+// and HTTP layers.
+package models
+
+import "time"
+
+// Task represents a task in our system.
+type Task struct {
+	ID          string    `json:"id" gorm:"primaryKey"`
+	Title       string    `json:"title" gorm:"size:100;not null" validate:"required,min=1,max=100"`
+	Description string    `json:"description" gorm:"size:500;not null" validate:"required,min=1,max=500"`
+	Priority    string    `json:"priority" gorm:"size:10;not null" validate:"required,oneof=low medium high"`
+	Status      string    `json:"status" gorm:"size:20;not null" validate:"oneof=pending in-progress completed"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	// ExecutionState tracks the background job (if any) processing this
+	// task, independent of the user-facing Status above. It is empty when
+	// the task was never enqueued.
+	ExecutionState string `json:"execution_state,omitempty" gorm:"size:20" validate:"omitempty,oneof=queued running succeeded failed cancelled"`
+	// JobID is the Asynq task ID returned at enqueue time, used to cancel
+	// or inspect the job later.
+	JobID string `json:"job_id,omitempty" gorm:"size:64"`
+
+	// OwnerID is the ID of the user who created this task. It is set by the
+	// service layer from the authenticated caller's claims and is never
+	// accepted from client-supplied request bodies.
+	OwnerID string `json:"owner_id" gorm:"size:64;index"`
+}
+
+// TableName pins the GORM table name so it doesn't depend on pluralization
+// rules that could change between GORM versions.
+func (Task) TableName() string {
+	return "tasks"
+}
+
+// CreateTaskRequest represents the request payload for creating a task.
+type CreateTaskRequest struct {
+	Title       string `json:"title" validate:"required,min=1,max=100"`
+	Description string `json:"description" validate:"required,min=1,max=500"`
+	Priority    string `json:"priority" validate:"required,oneof=low medium high"`
+	Status      string `json:"status,omitempty" validate:"omitempty,oneof=pending in-progress completed"`
+}
+
+// ReplaceTaskRequest represents the request payload for PUT /api/tasks/:id.
+// Unlike PATCH's merge/JSON-patch documents, PUT is a full-replace: every
+// field is required, so a caller can't omit a field and expect it preserved.
+type ReplaceTaskRequest struct {
+	Title       string `json:"title" validate:"required,min=1,max=100"`
+	Description string `json:"description" validate:"required,min=1,max=500"`
+	Priority    string `json:"priority" validate:"required,oneof=low medium high"`
+	Status      string `json:"status" validate:"required,oneof=pending in-progress completed"`
+}
+
+// TaskStats summarizes the task set by status and priority.
+type TaskStats struct {
+	Total      int `json:"total"`
+	Pending    int `json:"pending"`
+	InProgress int `json:"in-progress"`
+	Completed  int `json:"completed"`
+	Low        int `json:"low"`
+	Medium     int `json:"medium"`
+	High       int `json:"high"`
+}
+
+// RegisterRequest represents the request payload for creating an account.
+type RegisterRequest struct {
+	Username string `json:"username" validate:"required,min=3,max=50"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+}
+
+// LoginRequest represents the request payload for obtaining tokens.
+type LoginRequest struct {
+	Username string `json:"username" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+// AuthResponse carries the tokens issued at registration or login.
+type AuthResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+}
@@ -0,0 +1,50 @@
+package observability
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"go-demo/auth"
+)
+
+// NewLogger returns a production zap.Logger: JSON-encoded, info level.
+func NewLogger() (*zap.Logger, error) {
+	return zap.NewProduction()
+}
+
+// Logging returns a gin middleware that logs one JSON line per request via
+// logger, carrying the request ID set by RequestID(), the trace ID from the
+// span Tracing() started (if any), and the caller's user ID from the JWT
+// claims (if the route is authenticated).
+func Logging(logger *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		fields := []zap.Field{
+			zap.Int("status", c.Writer.Status()),
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.String("client_ip", c.ClientIP()),
+			zap.Duration("latency", time.Since(start)),
+		}
+
+		if id := RequestIDFromContext(c); id != "" {
+			fields = append(fields, zap.String("request_id", id))
+		}
+		if span := trace.SpanContextFromContext(c.Request.Context()); span.IsValid() {
+			fields = append(fields, zap.String("trace_id", span.TraceID().String()))
+		}
+		if claims, ok := auth.ClaimsFromContext(c); ok {
+			fields = append(fields, zap.String("user_id", claims.UserID))
+		}
+		if len(c.Errors) > 0 {
+			fields = append(fields, zap.String("errors", c.Errors.String()))
+		}
+
+		logger.Info("request", fields...)
+	}
+}
@@ -0,0 +1,86 @@
+package observability
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics holds the Prometheus collectors the API middleware records to. It
+// is constructed with its own namespace/subsystem (see config.ObservabilityConfig)
+// so deployments can tell this binary's metrics apart from others scraped by
+// the same Prometheus.
+type Metrics struct {
+	registry       *prometheus.Registry
+	requestLatency *prometheus.HistogramVec
+	taskOps        *prometheus.CounterVec
+	validationFail *prometheus.CounterVec
+}
+
+// NewMetrics builds and registers the collectors under namespace/subsystem.
+func NewMetrics(namespace, subsystem string) *Metrics {
+	registry := prometheus.NewRegistry()
+
+	m := &Metrics{
+		registry: registry,
+		requestLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency in seconds, labeled by route/method/status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method", "status"}),
+		taskOps: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "task_operations_total",
+			Help:      "Task CRUD operations, labeled by operation and outcome.",
+		}, []string{"operation", "outcome"}),
+		validationFail: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "validation_failures_total",
+			Help:      "Request validation failures, labeled by route.",
+		}, []string{"route"}),
+	}
+
+	registry.MustRegister(m.requestLatency, m.taskOps, m.validationFail)
+	return m
+}
+
+// Handler serves the registry's collectors in the Prometheus text format.
+func (m *Metrics) Handler() gin.HandlerFunc {
+	h := promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+	return gin.WrapH(h)
+}
+
+// Middleware records request latency for every request it sees, labeled by
+// the matched route (not the raw path, to keep cardinality bounded),
+// method, and resulting status code.
+func (m *Metrics) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		m.requestLatency.WithLabelValues(route, c.Request.Method, strconv.Itoa(c.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
+
+// RecordTaskOp increments the task-operation counter, e.g.
+// RecordTaskOp("create", "success").
+func (m *Metrics) RecordTaskOp(operation, outcome string) {
+	m.taskOps.WithLabelValues(operation, outcome).Inc()
+}
+
+// RecordValidationFailure increments the validation-failure counter for route.
+func (m *Metrics) RecordValidationFailure(route string) {
+	m.validationFail.WithLabelValues(route).Inc()
+}
@@ -0,0 +1,39 @@
+package observability
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// HeaderRequestID is the header used to read an inbound request ID and to
+// echo it back on the response.
+const HeaderRequestID = "X-Request-ID"
+
+// ContextRequestIDKey is the gin.Context key RequestID() stores the request
+// ID under, for middleware and handlers running after it.
+const ContextRequestIDKey = "request_id"
+
+// RequestID reads X-Request-ID from the incoming request, generating a UUID
+// when the caller didn't send one, and makes it available to later
+// middleware and handlers via c.Get(ContextRequestIDKey) and the response
+// header.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(HeaderRequestID)
+		if id == "" {
+			id = uuid.NewString()
+		}
+
+		c.Set(ContextRequestIDKey, id)
+		c.Header(HeaderRequestID, id)
+		c.Next()
+	}
+}
+
+// RequestIDFromContext returns the request ID stored by RequestID, or "" if
+// the middleware wasn't registered.
+func RequestIDFromContext(c *gin.Context) string {
+	id, _ := c.Get(ContextRequestIDKey)
+	s, _ := id.(string)
+	return s
+}
@@ -0,0 +1,277 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Operation is a single RFC 6902 JSON Patch operation.
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ApplyJSONPatch applies a sequence of RFC 6902 operations to original and
+// returns the resulting document. Operations are applied in order; if any
+// operation fails (including a failed "test"), the whole patch is rejected
+// and none of its side effects are returned.
+func ApplyJSONPatch(original []byte, ops []Operation) ([]byte, error) {
+	var doc interface{}
+	if len(original) > 0 {
+		if err := json.Unmarshal(original, &doc); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, op := range ops {
+		var err error
+		switch op.Op {
+		case "add":
+			doc, err = opAdd(doc, op.Path, op.Value)
+		case "remove":
+			doc, err = opRemove(doc, op.Path)
+		case "replace":
+			doc, err = opReplace(doc, op.Path, op.Value)
+		case "move":
+			var moved interface{}
+			moved, err = getPointer(doc, op.From)
+			if err == nil {
+				doc, err = opRemove(doc, op.From)
+			}
+			if err == nil {
+				var raw []byte
+				if raw, err = json.Marshal(moved); err == nil {
+					doc, err = opAdd(doc, op.Path, raw)
+				}
+			}
+		case "copy":
+			var copied interface{}
+			copied, err = getPointer(doc, op.From)
+			if err == nil {
+				var raw []byte
+				if raw, err = json.Marshal(copied); err == nil {
+					doc, err = opAdd(doc, op.Path, raw)
+				}
+			}
+		case "test":
+			err = opTest(doc, op.Path, op.Value)
+		default:
+			err = fmt.Errorf("unsupported op %q", op.Op)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("patch: op %q at %q: %w", op.Op, op.Path, err)
+		}
+	}
+
+	return json.Marshal(doc)
+}
+
+func splitPointer(path string) []string {
+	if path == "" || path == "/" {
+		return nil
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, p := range parts {
+		p = strings.ReplaceAll(p, "~1", "/")
+		p = strings.ReplaceAll(p, "~0", "~")
+		parts[i] = p
+	}
+	return parts
+}
+
+func getPointer(doc interface{}, path string) (interface{}, error) {
+	cur := doc
+	for _, part := range splitPointer(path) {
+		switch node := cur.(type) {
+		case map[string]interface{}:
+			v, ok := node[part]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", part)
+			}
+			cur = v
+		case []interface{}:
+			idx, err := arrayIndex(part, len(node))
+			if err != nil {
+				return nil, err
+			}
+			cur = node[idx]
+		default:
+			return nil, fmt.Errorf("cannot descend into non-container at %q", part)
+		}
+	}
+	return cur, nil
+}
+
+func arrayIndex(part string, length int) (int, error) {
+	idx, err := strconv.Atoi(part)
+	if err != nil || idx < 0 || idx >= length {
+		return 0, fmt.Errorf("index %q out of range", part)
+	}
+	return idx, nil
+}
+
+// setAtPointer returns a copy of doc with value placed at path, creating
+// map entries or growing slices (via the "-" append token) as needed. insert
+// selects RFC 6902 "add" semantics for an existing array index (the new
+// element is inserted and the tail shifts right) rather than "replace"
+// semantics (the element at idx is overwritten in place).
+func setAtPointer(doc interface{}, path string, value interface{}, insert bool) (interface{}, error) {
+	parts := splitPointer(path)
+	if len(parts) == 0 {
+		return value, nil
+	}
+	return setRecursive(doc, parts, value, insert)
+}
+
+func setRecursive(node interface{}, parts []string, value interface{}, insert bool) (interface{}, error) {
+	key := parts[0]
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if len(parts) == 1 {
+			n[key] = value
+			return n, nil
+		}
+		child, ok := n[key]
+		if !ok {
+			return nil, fmt.Errorf("member %q not found", key)
+		}
+		updated, err := setRecursive(child, parts[1:], value, insert)
+		if err != nil {
+			return nil, err
+		}
+		n[key] = updated
+		return n, nil
+	case []interface{}:
+		if key == "-" {
+			if len(parts) == 1 {
+				return append(n, value), nil
+			}
+			return nil, fmt.Errorf("cannot descend past array append token")
+		}
+		idx, err := strconv.Atoi(key)
+		if err != nil || idx < 0 || idx > len(n) {
+			return nil, fmt.Errorf("index %q out of range", key)
+		}
+		if len(parts) == 1 {
+			if idx == len(n) {
+				return append(n, value), nil
+			}
+			if insert {
+				n = append(n, nil)
+				copy(n[idx+1:], n[idx:])
+				n[idx] = value
+				return n, nil
+			}
+			n[idx] = value
+			return n, nil
+		}
+		updated, err := setRecursive(n[idx], parts[1:], value, insert)
+		if err != nil {
+			return nil, err
+		}
+		n[idx] = updated
+		return n, nil
+	default:
+		return nil, fmt.Errorf("cannot descend into non-container at %q", key)
+	}
+}
+
+func removeAtPointer(doc interface{}, path string) (interface{}, error) {
+	parts := splitPointer(path)
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("cannot remove document root")
+	}
+	return removeRecursive(doc, parts)
+}
+
+func removeRecursive(node interface{}, parts []string) (interface{}, error) {
+	key := parts[0]
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if len(parts) == 1 {
+			if _, ok := n[key]; !ok {
+				return nil, fmt.Errorf("member %q not found", key)
+			}
+			delete(n, key)
+			return n, nil
+		}
+		child, ok := n[key]
+		if !ok {
+			return nil, fmt.Errorf("member %q not found", key)
+		}
+		updated, err := removeRecursive(child, parts[1:])
+		if err != nil {
+			return nil, err
+		}
+		n[key] = updated
+		return n, nil
+	case []interface{}:
+		idx, err := arrayIndex(key, len(n))
+		if err != nil {
+			return nil, err
+		}
+		if len(parts) == 1 {
+			return append(n[:idx], n[idx+1:]...), nil
+		}
+		updated, err := removeRecursive(n[idx], parts[1:])
+		if err != nil {
+			return nil, err
+		}
+		n[idx] = updated
+		return n, nil
+	default:
+		return nil, fmt.Errorf("cannot descend into non-container at %q", key)
+	}
+}
+
+func opAdd(doc interface{}, path string, value json.RawMessage) (interface{}, error) {
+	var v interface{}
+	if err := json.Unmarshal(value, &v); err != nil {
+		return nil, err
+	}
+	return setAtPointer(doc, path, v, true)
+}
+
+func opReplace(doc interface{}, path string, value json.RawMessage) (interface{}, error) {
+	if _, err := getPointer(doc, path); err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(value, &v); err != nil {
+		return nil, err
+	}
+	return setAtPointer(doc, path, v, false)
+}
+
+func opRemove(doc interface{}, path string) (interface{}, error) {
+	return removeAtPointer(doc, path)
+}
+
+func opTest(doc interface{}, path string, value json.RawMessage) error {
+	var expected interface{}
+	if err := json.Unmarshal(value, &expected); err != nil {
+		return err
+	}
+
+	actual, err := getPointer(doc, path)
+	if err != nil {
+		return err
+	}
+
+	actualJSON, err := json.Marshal(actual)
+	if err != nil {
+		return err
+	}
+	expectedJSON, err := json.Marshal(expected)
+	if err != nil {
+		return err
+	}
+	if string(actualJSON) != string(expectedJSON) {
+		return fmt.Errorf("value does not match")
+	}
+	return nil
+}
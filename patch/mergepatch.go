@@ -0,0 +1,54 @@
+// Package patch implements RFC 7396 (JSON Merge Patch) and RFC 6902 (JSON
+// Patch) application against arbitrary JSON documents, so any handler that
+// needs partial-update semantics can share the same implementation instead
+// of hand-rolling field-by-field merges.
+package patch
+
+import "encoding/json"
+
+// ApplyMergePatch applies a RFC 7396 JSON Merge Patch document to original
+// and returns the resulting document. A null value for a key removes that
+// key from the target object; any other value replaces it. Patch members
+// are applied recursively to nested objects, and non-object values (arrays,
+// scalars) are replaced wholesale rather than merged.
+func ApplyMergePatch(original, mergePatch []byte) ([]byte, error) {
+	var target interface{}
+	if len(original) > 0 {
+		if err := json.Unmarshal(original, &target); err != nil {
+			return nil, err
+		}
+	}
+
+	var patchDoc interface{}
+	if err := json.Unmarshal(mergePatch, &patchDoc); err != nil {
+		return nil, err
+	}
+
+	merged := mergeMergePatch(target, patchDoc)
+	return json.Marshal(merged)
+}
+
+func mergeMergePatch(target, patchDoc interface{}) interface{} {
+	patchObj, ok := patchDoc.(map[string]interface{})
+	if !ok {
+		// The patch isn't an object, so per RFC 7396 it replaces the target
+		// entirely.
+		return patchDoc
+	}
+
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		// The target isn't an object (or doesn't exist yet); start fresh.
+		targetObj = make(map[string]interface{})
+	}
+
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+		targetObj[key] = mergeMergePatch(targetObj[key], value)
+	}
+
+	return targetObj
+}
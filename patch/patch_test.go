@@ -0,0 +1,171 @@
+package patch
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestApplyMergePatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		patch    string
+		want     string
+	}{
+		{
+			name:     "replace a field",
+			original: `{"title":"old","priority":"low"}`,
+			patch:    `{"title":"new"}`,
+			want:     `{"priority":"low","title":"new"}`,
+		},
+		{
+			name:     "null deletes a field",
+			original: `{"title":"old","description":"keep me"}`,
+			patch:    `{"title":null}`,
+			want:     `{"description":"keep me"}`,
+		},
+		{
+			name:     "nested object merges recursively",
+			original: `{"meta":{"a":1,"b":2}}`,
+			patch:    `{"meta":{"b":null,"c":3}}`,
+			want:     `{"meta":{"a":1,"c":3}}`,
+		},
+		{
+			name:     "non-object patch replaces wholesale",
+			original: `{"tags":["a","b"]}`,
+			patch:    `{"tags":["c"]}`,
+			want:     `{"tags":["c"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyMergePatch([]byte(tt.original), []byte(tt.patch))
+			if err != nil {
+				t.Fatalf("ApplyMergePatch() error = %v", err)
+			}
+			assertJSONEqual(t, got, []byte(tt.want))
+		})
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		ops      []Operation
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "add a field",
+			original: `{"title":"old"}`,
+			ops:      []Operation{{Op: "add", Path: "/priority", Value: json.RawMessage(`"high"`)}},
+			want:     `{"title":"old","priority":"high"}`,
+		},
+		{
+			name:     "remove a field",
+			original: `{"title":"old","priority":"high"}`,
+			ops:      []Operation{{Op: "remove", Path: "/priority"}},
+			want:     `{"title":"old"}`,
+		},
+		{
+			name:     "replace a field",
+			original: `{"title":"old"}`,
+			ops:      []Operation{{Op: "replace", Path: "/title", Value: json.RawMessage(`"new"`)}},
+			want:     `{"title":"new"}`,
+		},
+		{
+			name:     "move a field",
+			original: `{"title":"old","draft_title":"new"}`,
+			ops:      []Operation{{Op: "move", From: "/draft_title", Path: "/title"}},
+			want:     `{"title":"new"}`,
+		},
+		{
+			name:     "copy a field",
+			original: `{"title":"old"}`,
+			ops:      []Operation{{Op: "copy", From: "/title", Path: "/backup_title"}},
+			want:     `{"title":"old","backup_title":"old"}`,
+		},
+		{
+			name:     "test op passes",
+			original: `{"title":"old"}`,
+			ops: []Operation{
+				{Op: "test", Path: "/title", Value: json.RawMessage(`"old"`)},
+				{Op: "replace", Path: "/title", Value: json.RawMessage(`"new"`)},
+			},
+			want: `{"title":"new"}`,
+		},
+		{
+			name:     "test op fails rejects whole patch",
+			original: `{"title":"old"}`,
+			ops: []Operation{
+				{Op: "test", Path: "/title", Value: json.RawMessage(`"nope"`)},
+				{Op: "replace", Path: "/title", Value: json.RawMessage(`"new"`)},
+			},
+			wantErr: true,
+		},
+		{
+			name:     "replace missing path errors",
+			original: `{"title":"old"}`,
+			ops:      []Operation{{Op: "replace", Path: "/missing", Value: json.RawMessage(`"x"`)}},
+			wantErr:  true,
+		},
+		{
+			name:     "array append via dash token",
+			original: `{"tags":["a"]}`,
+			ops:      []Operation{{Op: "add", Path: "/tags/-", Value: json.RawMessage(`"b"`)}},
+			want:     `{"tags":["a","b"]}`,
+		},
+		{
+			name:     "array remove by index",
+			original: `{"tags":["a","b","c"]}`,
+			ops:      []Operation{{Op: "remove", Path: "/tags/1"}},
+			want:     `{"tags":["a","c"]}`,
+		},
+		{
+			name:     "array add at existing index inserts and shifts the tail",
+			original: `{"tags":["a","c"]}`,
+			ops:      []Operation{{Op: "add", Path: "/tags/1", Value: json.RawMessage(`"b"`)}},
+			want:     `{"tags":["a","b","c"]}`,
+		},
+		{
+			name:     "array replace at existing index overwrites in place",
+			original: `{"tags":["a","b","c"]}`,
+			ops:      []Operation{{Op: "replace", Path: "/tags/1", Value: json.RawMessage(`"x"`)}},
+			want:     `{"tags":["a","x","c"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyJSONPatch([]byte(tt.original), tt.ops)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ApplyJSONPatch() expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApplyJSONPatch() error = %v", err)
+			}
+			assertJSONEqual(t, got, []byte(tt.want))
+		})
+	}
+}
+
+func assertJSONEqual(t *testing.T, got, want []byte) {
+	t.Helper()
+	var gotVal, wantVal interface{}
+	if err := json.Unmarshal(got, &gotVal); err != nil {
+		t.Fatalf("invalid JSON produced: %v", err)
+	}
+	if err := json.Unmarshal(want, &wantVal); err != nil {
+		t.Fatalf("invalid JSON in test table: %v", err)
+	}
+	gotNorm, _ := json.Marshal(gotVal)
+	wantNorm, _ := json.Marshal(wantVal)
+	if string(gotNorm) != string(wantNorm) {
+		t.Errorf("got %s, want %s", gotNorm, wantNorm)
+	}
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"go-demo/models"
+)
+
+// MemoryRepository is an in-memory TaskRepository, used for local
+// development and in tests. It is safe for concurrent use.
+type MemoryRepository struct {
+	mu    sync.RWMutex
+	tasks map[string]*models.Task
+}
+
+// NewMemoryRepository returns an empty in-memory repository.
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		tasks: make(map[string]*models.Task),
+	}
+}
+
+func (r *MemoryRepository) Create(ctx context.Context, task *models.Task) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *task
+	r.tasks[task.ID] = &stored
+	return nil
+}
+
+func (r *MemoryRepository) Get(ctx context.Context, id string) (*models.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	task, ok := r.tasks[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	copied := *task
+	return &copied, nil
+}
+
+func (r *MemoryRepository) List(ctx context.Context) ([]*models.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]*models.Task, 0, len(r.tasks))
+	for _, task := range r.tasks {
+		copied := *task
+		list = append(list, &copied)
+	}
+	return list, nil
+}
+
+func (r *MemoryRepository) Update(ctx context.Context, task *models.Task) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tasks[task.ID]; !ok {
+		return ErrNotFound
+	}
+	stored := *task
+	r.tasks[task.ID] = &stored
+	return nil
+}
+
+func (r *MemoryRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tasks[id]; !ok {
+		return ErrNotFound
+	}
+	delete(r.tasks, id)
+	return nil
+}
+
+func (r *MemoryRepository) Stats(ctx context.Context) (models.TaskStats, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var stats models.TaskStats
+	stats.Total = len(r.tasks)
+	for _, task := range r.tasks {
+		switch task.Status {
+		case "pending":
+			stats.Pending++
+		case "in-progress":
+			stats.InProgress++
+		case "completed":
+			stats.Completed++
+		}
+		switch task.Priority {
+		case "low":
+			stats.Low++
+		case "medium":
+			stats.Medium++
+		case "high":
+			stats.High++
+		}
+	}
+	return stats, nil
+}
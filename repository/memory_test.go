@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"go-demo/models"
+)
+
+func TestMemoryRepositoryCRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	task := &models.Task{ID: "1", Title: "a", Description: "b", Priority: "low", Status: "pending"}
+	if err := repo.Create(ctx, task); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	got, err := repo.Get(ctx, "1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Title != "a" {
+		t.Errorf("Get() Title = %q, want %q", got.Title, "a")
+	}
+
+	got.Title = "mutated"
+	if original, _ := repo.Get(ctx, "1"); original.Title == "mutated" {
+		t.Errorf("Get() leaked internal storage; mutating the returned task changed stored state")
+	}
+
+	task.Title = "updated"
+	if err := repo.Update(ctx, task); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got, _ := repo.Get(ctx, "1"); got.Title != "updated" {
+		t.Errorf("Update() did not persist, got Title = %q", got.Title)
+	}
+
+	list, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(list) != 1 {
+		t.Fatalf("List() len = %d, want 1", len(list))
+	}
+
+	if err := repo.Delete(ctx, "1"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := repo.Get(ctx, "1"); err != ErrNotFound {
+		t.Errorf("Get() after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestMemoryRepositoryStats(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	repo.Create(ctx, &models.Task{ID: "1", Priority: "high", Status: "pending"})
+	repo.Create(ctx, &models.Task{ID: "2", Priority: "high", Status: "completed"})
+	repo.Create(ctx, &models.Task{ID: "3", Priority: "low", Status: "completed"})
+
+	stats, err := repo.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Stats() error = %v", err)
+	}
+	if stats.Total != 3 || stats.High != 2 || stats.Low != 1 || stats.Completed != 2 || stats.Pending != 1 {
+		t.Errorf("Stats() = %+v, unexpected counts", stats)
+	}
+}
+
+func TestMemoryRepositoryNotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	if _, err := repo.Get(ctx, "missing"); err != ErrNotFound {
+		t.Errorf("Get() error = %v, want ErrNotFound", err)
+	}
+	if err := repo.Update(ctx, &models.Task{ID: "missing"}); err != ErrNotFound {
+		t.Errorf("Update() error = %v, want ErrNotFound", err)
+	}
+	if err := repo.Delete(ctx, "missing"); err != ErrNotFound {
+		t.Errorf("Delete() error = %v, want ErrNotFound", err)
+	}
+}
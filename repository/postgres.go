@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"go-demo/config"
+	"go-demo/models"
+)
+
+// PostgresRepository is a GORM-backed TaskRepository.
+type PostgresRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresRepository opens a connection pool against cfg, runs the
+// `tasks` table migration, and returns a ready-to-use repository.
+func NewPostgresRepository(cfg config.DatabaseConfig) (*PostgresRepository, error) {
+	db, err := gorm.Open(postgres.Open(cfg.DSN()), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("repository: connecting to postgres: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("repository: unwrapping sql.DB: %w", err)
+	}
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
+	if err := db.AutoMigrate(&models.Task{}); err != nil {
+		return nil, fmt.Errorf("repository: migrating tasks table: %w", err)
+	}
+
+	return &PostgresRepository{db: db}, nil
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, task *models.Task) error {
+	return r.db.WithContext(ctx).Create(task).Error
+}
+
+func (r *PostgresRepository) Get(ctx context.Context, id string) (*models.Task, error) {
+	var task models.Task
+	err := r.db.WithContext(ctx).First(&task, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (r *PostgresRepository) List(ctx context.Context) ([]*models.Task, error) {
+	var tasks []*models.Task
+	if err := r.db.WithContext(ctx).Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func (r *PostgresRepository) Update(ctx context.Context, task *models.Task) error {
+	result := r.db.WithContext(ctx).Model(&models.Task{}).Where("id = ?", task.ID).Updates(task)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *PostgresRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&models.Task{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *PostgresRepository) Stats(ctx context.Context) (models.TaskStats, error) {
+	var stats models.TaskStats
+	var tasks []*models.Task
+	if err := r.db.WithContext(ctx).Find(&tasks).Error; err != nil {
+		return stats, err
+	}
+
+	stats.Total = len(tasks)
+	for _, task := range tasks {
+		switch task.Status {
+		case "pending":
+			stats.Pending++
+		case "in-progress":
+			stats.InProgress++
+		case "completed":
+			stats.Completed++
+		}
+		switch task.Priority {
+		case "low":
+			stats.Low++
+		case "medium":
+			stats.Medium++
+		case "high":
+			stats.High++
+		}
+	}
+	return stats, nil
+}
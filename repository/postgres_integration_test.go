@@ -0,0 +1,56 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"go-demo/config"
+	"go-demo/models"
+)
+
+// TestPostgresRepositoryCRUD runs against a real Postgres instance on port
+// 5432, pointed at by the POSTGRES_HOST, POSTGRES_USER, POSTGRES_PASSWORD
+// and POSTGRES_DB environment variables (with local defaults when unset).
+// Run with: go test -tags=integration ./repository/...
+// against a dockerized `postgres:16` container.
+func TestPostgresRepositoryCRUD(t *testing.T) {
+	cfg := config.DatabaseConfig{
+		Host:     envOr("POSTGRES_HOST", "localhost"),
+		Port:     5432,
+		User:     envOr("POSTGRES_USER", "postgres"),
+		Password: envOr("POSTGRES_PASSWORD", "postgres"),
+		DBName:   envOr("POSTGRES_DB", "go_demo_test"),
+		SSLMode:  "disable",
+	}
+
+	repo, err := NewPostgresRepository(cfg)
+	if err != nil {
+		t.Fatalf("NewPostgresRepository() error = %v", err)
+	}
+
+	ctx := context.Background()
+	task := &models.Task{ID: "integration-1", Title: "a", Description: "b", Priority: "low", Status: "pending"}
+
+	if err := repo.Create(ctx, task); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	defer repo.Delete(ctx, task.ID)
+
+	got, err := repo.Get(ctx, task.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Title != "a" {
+		t.Errorf("Get() Title = %q, want %q", got.Title, "a")
+	}
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
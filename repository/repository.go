@@ -0,0 +1,25 @@
+// Package repository defines the storage contract for tasks and provides
+// in-memory and Postgres implementations behind the same interface.
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"go-demo/models"
+)
+
+// ErrNotFound is returned by implementations when a task ID doesn't exist.
+var ErrNotFound = errors.New("repository: task not found")
+
+// TaskRepository is the storage contract for tasks. Handlers never talk to
+// a concrete store directly; they go through a TaskService, which in turn
+// depends only on this interface.
+type TaskRepository interface {
+	Create(ctx context.Context, task *models.Task) error
+	Get(ctx context.Context, id string) (*models.Task, error)
+	List(ctx context.Context) ([]*models.Task, error)
+	Update(ctx context.Context, task *models.Task) error
+	Delete(ctx context.Context, id string) error
+	Stats(ctx context.Context) (models.TaskStats, error)
+}
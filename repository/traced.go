@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"go-demo/models"
+)
+
+// tracedRepository wraps a TaskRepository so every call opens a child span
+// under whatever span is already on ctx (the one the observability.Tracing
+// middleware starts per request), recording the task ID involved and
+// marking the span as errored on failure.
+type tracedRepository struct {
+	next   TaskRepository
+	tracer trace.Tracer
+}
+
+// NewTracedRepository wraps next so each call is recorded as a child span
+// of the request span, using tracer to start them. Pass the tracer backing
+// the configured OpenTelemetry provider; with tracing disabled this is the
+// default no-op tracer, so spans cost nothing.
+func NewTracedRepository(next TaskRepository, tracer trace.Tracer) TaskRepository {
+	return &tracedRepository{next: next, tracer: tracer}
+}
+
+func (r *tracedRepository) startSpan(ctx context.Context, op, id string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{attribute.String("db.operation", op)}
+	if id != "" {
+		attrs = append(attrs, attribute.String("task.id", id))
+	}
+	return r.tracer.Start(ctx, "repository."+op, trace.WithAttributes(attrs...))
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (r *tracedRepository) Create(ctx context.Context, task *models.Task) error {
+	ctx, span := r.startSpan(ctx, "Create", task.ID)
+	err := r.next.Create(ctx, task)
+	endSpan(span, err)
+	return err
+}
+
+func (r *tracedRepository) Get(ctx context.Context, id string) (*models.Task, error) {
+	ctx, span := r.startSpan(ctx, "Get", id)
+	task, err := r.next.Get(ctx, id)
+	endSpan(span, err)
+	return task, err
+}
+
+func (r *tracedRepository) List(ctx context.Context) ([]*models.Task, error) {
+	ctx, span := r.startSpan(ctx, "List", "")
+	tasks, err := r.next.List(ctx)
+	endSpan(span, err)
+	return tasks, err
+}
+
+func (r *tracedRepository) Update(ctx context.Context, task *models.Task) error {
+	ctx, span := r.startSpan(ctx, "Update", task.ID)
+	err := r.next.Update(ctx, task)
+	endSpan(span, err)
+	return err
+}
+
+func (r *tracedRepository) Delete(ctx context.Context, id string) error {
+	ctx, span := r.startSpan(ctx, "Delete", id)
+	err := r.next.Delete(ctx, id)
+	endSpan(span, err)
+	return err
+}
+
+func (r *tracedRepository) Stats(ctx context.Context) (models.TaskStats, error) {
+	ctx, span := r.startSpan(ctx, "Stats", "")
+	stats, err := r.next.Stats(ctx)
+	endSpan(span, err)
+	return stats, err
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"go-demo/models"
+)
+
+func TestTracedRepositoryDelegates(t *testing.T) {
+	ctx := context.Background()
+	inner := NewMemoryRepository()
+	repo := NewTracedRepository(inner, noop.NewTracerProvider().Tracer("test"))
+
+	task := &models.Task{ID: "1", Title: "a", Priority: "low", Status: "pending"}
+	if err := repo.Create(ctx, task); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	got, err := repo.Get(ctx, "1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Title != "a" {
+		t.Errorf("Get() Title = %q, want %q", got.Title, "a")
+	}
+
+	if _, err := repo.Get(ctx, "missing"); err != ErrNotFound {
+		t.Errorf("Get() error = %v, want ErrNotFound", err)
+	}
+}
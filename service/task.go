@@ -0,0 +1,192 @@
+// Package service holds business rules for tasks, sitting between the HTTP
+// handlers and a repository.TaskRepository.
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-demo/models"
+	"go-demo/repository"
+)
+
+// Enqueuer submits a background job to process a task and returns a job ID
+// that a Canceller can later use to stop it. A nil Enqueuer disables async
+// execution entirely: Create never enqueues a job.
+type Enqueuer interface {
+	Enqueue(ctx context.Context, taskID string) (jobID string, err error)
+}
+
+// Canceller signals a previously enqueued job to stop.
+type Canceller interface {
+	Cancel(jobID string) error
+}
+
+// TaskService applies default values and timestamp bookkeeping around a
+// TaskRepository so handlers don't have to.
+type TaskService struct {
+	repo      repository.TaskRepository
+	enqueuer  Enqueuer
+	canceller Canceller
+}
+
+// Option configures optional TaskService behavior.
+type Option func(*TaskService)
+
+// WithAsyncExecution wires a background job enqueuer/canceller into the
+// service, so Create enqueues a "process task" job and Cancel can stop it.
+func WithAsyncExecution(enqueuer Enqueuer, canceller Canceller) Option {
+	return func(s *TaskService) {
+		s.enqueuer = enqueuer
+		s.canceller = canceller
+	}
+}
+
+// New returns a TaskService backed by repo.
+func New(repo repository.TaskRepository, opts ...Option) *TaskService {
+	s := &TaskService{repo: repo}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Create builds a Task from req, applying the default "pending" status
+// when the caller didn't specify one, and persists it. ownerID is the
+// authenticated caller's user ID; it must come from trusted middleware
+// state, never from req.
+func (s *TaskService) Create(ctx context.Context, req models.CreateTaskRequest, ownerID string) (*models.Task, error) {
+	status := req.Status
+	if status == "" {
+		status = "pending"
+	}
+
+	now := time.Now()
+	task := &models.Task{
+		ID:          uuid.New().String(),
+		Title:       req.Title,
+		Description: req.Description,
+		Priority:    req.Priority,
+		Status:      status,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		OwnerID:     ownerID,
+	}
+
+	if err := s.repo.Create(ctx, task); err != nil {
+		return nil, err
+	}
+
+	if s.enqueuer != nil {
+		jobID, err := s.enqueuer.Enqueue(ctx, task.ID)
+		if err != nil {
+			return nil, fmt.Errorf("enqueue task %s: %w", task.ID, err)
+		}
+		task.JobID = jobID
+		task.ExecutionState = "queued"
+		if err := s.repo.Update(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	return task, nil
+}
+
+// Get returns a single task by ID.
+func (s *TaskService) Get(ctx context.Context, id string) (*models.Task, error) {
+	return s.repo.Get(ctx, id)
+}
+
+// List returns every task.
+func (s *TaskService) List(ctx context.Context) ([]*models.Task, error) {
+	return s.repo.List(ctx)
+}
+
+// Replace overwrites every user-editable field of the task with the given
+// ID to match req (PUT's full-replace semantic), bumps UpdatedAt, and
+// persists the result. See ApplyPatch for PATCH's partial-update semantic.
+func (s *TaskService) Replace(ctx context.Context, id string, req models.ReplaceTaskRequest) (*models.Task, error) {
+	task, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Title = req.Title
+	task.Description = req.Description
+	task.Priority = req.Priority
+	task.Status = req.Status
+	task.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(ctx, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// ApplyPatch persists a task that has already had a merge-patch or
+// JSON-patch document applied to it (see the patch package), preserving
+// the original ID and CreatedAt and bumping UpdatedAt.
+func (s *TaskService) ApplyPatch(ctx context.Context, id string, patched *models.Task) (*models.Task, error) {
+	existing, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	patched.ID = existing.ID
+	patched.CreatedAt = existing.CreatedAt
+	patched.OwnerID = existing.OwnerID
+	patched.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(ctx, patched); err != nil {
+		return nil, err
+	}
+	return patched, nil
+}
+
+// SetExecutionState updates a task's ExecutionState, used by the worker to
+// record job progress (queued -> running -> succeeded/failed/cancelled).
+func (s *TaskService) SetExecutionState(ctx context.Context, id, state string) error {
+	task, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	task.ExecutionState = state
+	task.UpdatedAt = time.Now()
+	return s.repo.Update(ctx, task)
+}
+
+// Cancel signals the background job processing the task with the given ID
+// to stop. It returns an error if async execution isn't configured or the
+// task was never enqueued (it has no JobID); it does not check whether the
+// job is still running.
+func (s *TaskService) Cancel(ctx context.Context, id string) (*models.Task, error) {
+	if s.canceller == nil {
+		return nil, fmt.Errorf("async execution is not configured")
+	}
+
+	task, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if task.JobID == "" {
+		return nil, fmt.Errorf("task %s has no in-flight job", id)
+	}
+
+	if err := s.canceller.Cancel(task.JobID); err != nil {
+		return nil, fmt.Errorf("cancel job %s: %w", task.JobID, err)
+	}
+	return task, nil
+}
+
+// Delete removes the task with the given ID.
+func (s *TaskService) Delete(ctx context.Context, id string) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// Stats summarizes all tasks by status and priority.
+func (s *TaskService) Stats(ctx context.Context) (models.TaskStats, error) {
+	return s.repo.Stats(ctx)
+}
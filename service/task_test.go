@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-demo/models"
+	"go-demo/repository"
+)
+
+var errEnqueue = errors.New("enqueue failed")
+
+func TestCreateDefaultsStatusToPending(t *testing.T) {
+	svc := New(repository.NewMemoryRepository())
+
+	task, err := svc.Create(context.Background(), models.CreateTaskRequest{
+		Title:       "a",
+		Description: "b",
+		Priority:    "low",
+	}, "owner-1")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if task.Status != "pending" {
+		t.Errorf("Create() Status = %q, want %q", task.Status, "pending")
+	}
+}
+
+func TestReplaceBumpsUpdatedAt(t *testing.T) {
+	svc := New(repository.NewMemoryRepository())
+
+	created, err := svc.Create(context.Background(), models.CreateTaskRequest{
+		Title: "a", Description: "b", Priority: "low",
+	}, "owner-1")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	updated, err := svc.Replace(context.Background(), created.ID, models.ReplaceTaskRequest{
+		Title: "updated", Description: "b", Priority: "low", Status: "pending",
+	})
+	if err != nil {
+		t.Fatalf("Replace() error = %v", err)
+	}
+	if updated.Title != "updated" {
+		t.Errorf("Replace() Title = %q, want %q", updated.Title, "updated")
+	}
+	if !updated.UpdatedAt.After(created.UpdatedAt) && !updated.UpdatedAt.Equal(created.UpdatedAt) {
+		t.Errorf("Replace() UpdatedAt = %v, want >= %v", updated.UpdatedAt, created.UpdatedAt)
+	}
+}
+
+func TestReplaceOverwritesOmittedFields(t *testing.T) {
+	svc := New(repository.NewMemoryRepository())
+
+	created, err := svc.Create(context.Background(), models.CreateTaskRequest{
+		Title: "a", Description: "b", Priority: "low", Status: "in-progress",
+	}, "owner-1")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	updated, err := svc.Replace(context.Background(), created.ID, models.ReplaceTaskRequest{
+		Title: "a", Description: "b", Priority: "low", Status: "pending",
+	})
+	if err != nil {
+		t.Fatalf("Replace() error = %v", err)
+	}
+	if updated.Status != "pending" {
+		t.Errorf("Replace() Status = %q, want %q (full replace should not preserve the old value)", updated.Status, "pending")
+	}
+}
+
+func TestReplaceMissingTaskReturnsNotFound(t *testing.T) {
+	svc := New(repository.NewMemoryRepository())
+
+	req := models.ReplaceTaskRequest{Title: "x", Description: "y", Priority: "low", Status: "pending"}
+	if _, err := svc.Replace(context.Background(), "missing", req); err != repository.ErrNotFound {
+		t.Errorf("Replace() error = %v, want ErrNotFound", err)
+	}
+}
+
+type fakeEnqueuer struct {
+	jobID string
+	err   error
+}
+
+func (f *fakeEnqueuer) Enqueue(ctx context.Context, taskID string) (string, error) {
+	return f.jobID, f.err
+}
+
+type fakeCanceller struct {
+	cancelled []string
+	err       error
+}
+
+func (f *fakeCanceller) Cancel(jobID string) error {
+	f.cancelled = append(f.cancelled, jobID)
+	return f.err
+}
+
+func TestCreateWithAsyncExecutionSetsJobIDAndQueuedState(t *testing.T) {
+	svc := New(repository.NewMemoryRepository(), WithAsyncExecution(&fakeEnqueuer{jobID: "job-1"}, &fakeCanceller{}))
+
+	task, err := svc.Create(context.Background(), models.CreateTaskRequest{Title: "a", Description: "b", Priority: "low"}, "owner-1")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if task.JobID != "job-1" {
+		t.Errorf("Create() JobID = %q, want %q", task.JobID, "job-1")
+	}
+	if task.ExecutionState != "queued" {
+		t.Errorf("Create() ExecutionState = %q, want %q", task.ExecutionState, "queued")
+	}
+}
+
+func TestCreateReturnsErrorWhenEnqueueFails(t *testing.T) {
+	svc := New(repository.NewMemoryRepository(), WithAsyncExecution(&fakeEnqueuer{err: errEnqueue}, &fakeCanceller{}))
+
+	if _, err := svc.Create(context.Background(), models.CreateTaskRequest{Title: "a", Description: "b", Priority: "low"}, "owner-1"); err == nil {
+		t.Fatal("Create() expected error when enqueue fails")
+	}
+}
+
+func TestCancelSignalsJobAndReportsMissingTask(t *testing.T) {
+	svc := New(repository.NewMemoryRepository(), WithAsyncExecution(&fakeEnqueuer{jobID: "job-1"}, &fakeCanceller{}))
+
+	task, err := svc.Create(context.Background(), models.CreateTaskRequest{Title: "a", Description: "b", Priority: "low"}, "owner-1")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if _, err := svc.Cancel(context.Background(), task.ID); err != nil {
+		t.Errorf("Cancel() error = %v, want nil", err)
+	}
+
+	if _, err := svc.Cancel(context.Background(), "missing"); err != repository.ErrNotFound {
+		t.Errorf("Cancel() error = %v, want ErrNotFound", err)
+	}
+}
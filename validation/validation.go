@@ -0,0 +1,121 @@
+// Package validation wires project-specific rules into a
+// validator.Validate instance and translates its errors into the
+// apierror.FieldDetail shape the API returns to clients.
+package validation
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	entranslations "github.com/go-playground/validator/v10/translations/en"
+
+	"go-demo/apierror"
+)
+
+// priorityRank orders Task.Priority values so priority_order can tell a
+// promotion from a demotion.
+var priorityRank = map[string]int{"low": 0, "medium": 1, "high": 2}
+
+// New returns a validator.Validate with the project's custom validators
+// registered, plus a universal translator seeded with English. Additional
+// locales can be added to the translator later without touching this
+// function's callers.
+func New() (*validator.Validate, *ut.UniversalTranslator, error) {
+	v := validator.New()
+	if err := v.RegisterValidation("priority_order", validatePriorityOrder); err != nil {
+		return nil, nil, err
+	}
+
+	enLocale := en.New()
+	translator := ut.New(enLocale, enLocale)
+	trans, _ := translator.GetTranslator("en")
+
+	if err := entranslations.RegisterDefaultTranslations(v, trans); err != nil {
+		return nil, nil, err
+	}
+	if err := v.RegisterTranslation("priority_order", trans, registerPriorityOrder, translatePriorityOrder); err != nil {
+		return nil, nil, err
+	}
+
+	return v, translator, nil
+}
+
+// Translator returns the translator for acceptLanguage, falling back to
+// English when the header is empty or names a locale we haven't
+// registered.
+func Translator(translator *ut.UniversalTranslator, acceptLanguage string) ut.Translator {
+	if acceptLanguage != "" {
+		if trans, ok := translator.GetTranslator(acceptLanguage); ok {
+			return trans
+		}
+	}
+	trans, _ := translator.GetTranslator("en")
+	return trans
+}
+
+// validatePriorityOrder enforces that the tagged field's priority can only
+// move up the low < medium < high scale relative to the sibling field
+// named by the tag's param (e.g. `validate:"priority_order=Current"`).
+// Fields left empty on either side are treated as "no constraint".
+func validatePriorityOrder(fl validator.FieldLevel) bool {
+	newValue := fl.Field().String()
+	if newValue == "" {
+		return true
+	}
+
+	parent := fl.Parent()
+	if parent.Kind() == reflect.Ptr {
+		parent = parent.Elem()
+	}
+	sibling := parent.FieldByName(fl.Param())
+	if !sibling.IsValid() {
+		return false
+	}
+	currentValue := sibling.String()
+	if currentValue == "" {
+		return true
+	}
+
+	newRank, ok := priorityRank[newValue]
+	if !ok {
+		return false
+	}
+	currentRank, ok := priorityRank[currentValue]
+	if !ok {
+		return false
+	}
+	return newRank >= currentRank
+}
+
+func registerPriorityOrder(trans ut.Translator) error {
+	return trans.Add("priority_order", "{0} cannot be downgraded from its current priority", true)
+}
+
+func translatePriorityOrder(trans ut.Translator, fe validator.FieldError) string {
+	message, _ := trans.T("priority_order", fe.Field())
+	return message
+}
+
+// Details converts err into field-level details translated with trans. It
+// returns nil if err isn't a validator.ValidationErrors.
+func Details(err error, trans ut.Translator) []apierror.FieldDetail {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	details := make([]apierror.FieldDetail, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		details = append(details, apierror.FieldDetail{
+			Field:   fe.Field(),
+			Tag:     fe.Tag(),
+			Param:   fe.Param(),
+			Value:   fe.Value(),
+			Message: fe.Translate(trans),
+		})
+	}
+	return details
+}
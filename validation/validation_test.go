@@ -0,0 +1,74 @@
+package validation
+
+import "testing"
+
+type transitionCheck struct {
+	Current  string `validate:"omitempty,oneof=low medium high"`
+	Priority string `validate:"omitempty,priority_order=Current"`
+}
+
+func TestPriorityOrderAllowsPromotionOrSamePriority(t *testing.T) {
+	v, _, err := New()
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	cases := []transitionCheck{
+		{Current: "low", Priority: "low"},
+		{Current: "low", Priority: "high"},
+		{Current: "medium", Priority: "high"},
+		{Current: "", Priority: "low"},
+	}
+
+	for _, tc := range cases {
+		if err := v.Struct(tc); err != nil {
+			t.Errorf("Struct(%+v): unexpected error: %v", tc, err)
+		}
+	}
+}
+
+func TestPriorityOrderRejectsDowngrade(t *testing.T) {
+	v, _, err := New()
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	tc := transitionCheck{Current: "high", Priority: "low"}
+	if err := v.Struct(tc); err == nil {
+		t.Fatal("expected a validation error for a priority downgrade, got nil")
+	}
+}
+
+func TestDetailsTranslatesValidationErrors(t *testing.T) {
+	v, translator, err := New()
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	err = v.Struct(transitionCheck{Current: "high", Priority: "low"})
+	if err == nil {
+		t.Fatal("expected a validation error")
+	}
+
+	details := Details(err, Translator(translator, ""))
+	if len(details) != 1 {
+		t.Fatalf("expected 1 detail, got %d", len(details))
+	}
+
+	d := details[0]
+	if d.Field != "Priority" {
+		t.Errorf("Field = %q, want %q", d.Field, "Priority")
+	}
+	if d.Tag != "priority_order" {
+		t.Errorf("Tag = %q, want %q", d.Tag, "priority_order")
+	}
+	if d.Message == "" {
+		t.Error("Message should not be empty")
+	}
+}
+
+func TestDetailsReturnsNilForNonValidationError(t *testing.T) {
+	if details := Details(nil, nil); details != nil {
+		t.Errorf("expected nil, got %v", details)
+	}
+}
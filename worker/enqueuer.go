@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"go-demo/config"
+)
+
+// Enqueuer submits "process task" jobs to Asynq. It implements
+// service.Enqueuer; cancellation (service.Canceller) is done by Inspector.
+type Enqueuer struct {
+	client   *asynq.Client
+	queue    string
+	maxRetry int
+	timeout  time.Duration
+}
+
+// NewEnqueuer connects to Redis at cfg.RedisAddr and returns an Enqueuer
+// configured from cfg.
+func NewEnqueuer(cfg config.QueueConfig) *Enqueuer {
+	return &Enqueuer{
+		client:   asynq.NewClient(asynq.RedisClientOpt{Addr: cfg.RedisAddr}),
+		queue:    cfg.Queue,
+		maxRetry: cfg.MaxRetry,
+		timeout:  cfg.ProcessTimeout,
+	}
+}
+
+// Close releases the underlying Redis connection.
+func (e *Enqueuer) Close() error {
+	return e.client.Close()
+}
+
+// Enqueue submits a TypeProcessTask job for taskID and returns the Asynq
+// job ID, which callers can later pass to Cancel. Asynq retries a failing
+// job up to maxRetry times with its default exponential backoff.
+func (e *Enqueuer) Enqueue(ctx context.Context, taskID string) (string, error) {
+	payload, err := ProcessTaskPayload{TaskID: taskID}.marshal()
+	if err != nil {
+		return "", fmt.Errorf("worker: encoding payload: %w", err)
+	}
+
+	info, err := e.client.EnqueueContext(ctx, asynq.NewTask(TypeProcessTask, payload),
+		asynq.Queue(e.queue),
+		asynq.MaxRetry(e.maxRetry),
+		asynq.Timeout(e.timeout),
+	)
+	if err != nil {
+		return "", fmt.Errorf("worker: enqueueing task %s: %w", taskID, err)
+	}
+	return info.ID, nil
+}
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"go-demo/events"
+	"go-demo/service"
+)
+
+// Processor handles TypeProcessTask jobs: it moves a task's
+// ExecutionState through running -> succeeded/cancelled, publishing each
+// transition to the events broker for SSE subscribers. It never sets the
+// "failed" state; a job that returns an error is left to Asynq's retries.
+type Processor struct {
+	tasks  *service.TaskService
+	events *events.Broker
+}
+
+// NewProcessor returns a Processor that updates tasks via svc and
+// publishes state transitions via broker.
+func NewProcessor(svc *service.TaskService, broker *events.Broker) *Processor {
+	return &Processor{tasks: svc, events: broker}
+}
+
+// ProcessTask is the asynq.HandlerFunc for TypeProcessTask.
+func (p *Processor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	var payload ProcessTaskPayload
+	if err := payload.unmarshal(t.Payload()); err != nil {
+		return fmt.Errorf("worker: decoding payload: %w", err)
+	}
+
+	if err := p.setState(ctx, payload.TaskID, "running", "processing started"); err != nil {
+		return err
+	}
+
+	// Simulate the work a real task processor would do, checking
+	// periodically for the cancellation signal Asynq delivers to ctx when
+	// an operator calls the /cancel endpoint (which in turn calls
+	// Inspector.Cancel).
+	const steps = 5
+	for i := 0; i < steps; i++ {
+		select {
+		case <-ctx.Done():
+			p.setState(context.Background(), payload.TaskID, "cancelled", "processing cancelled")
+			return ctx.Err()
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+
+	if err := p.setState(ctx, payload.TaskID, "succeeded", "processing complete"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// setState persists the new ExecutionState and publishes it as an event.
+// A failure to persist is treated as a failed job so Asynq will retry it.
+func (p *Processor) setState(ctx context.Context, taskID, state, message string) error {
+	if err := p.tasks.SetExecutionState(ctx, taskID, state); err != nil {
+		return fmt.Errorf("worker: updating task %s state to %s: %w", taskID, state, err)
+	}
+	if p.events != nil {
+		_ = p.events.Publish(ctx, events.Event{
+			TaskID:    taskID,
+			State:     state,
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+	}
+	return nil
+}
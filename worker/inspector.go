@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"go-demo/config"
+)
+
+// Inspector wraps asynq.Inspector to cancel in-flight jobs and list jobs
+// that exhausted their retries (the dead letter / "archived" queue).
+type Inspector struct {
+	inspector *asynq.Inspector
+	queue     string
+}
+
+// NewInspector connects to Redis at cfg.RedisAddr.
+func NewInspector(cfg config.QueueConfig) *Inspector {
+	return &Inspector{
+		inspector: asynq.NewInspector(asynq.RedisClientOpt{Addr: cfg.RedisAddr}),
+		queue:     cfg.Queue,
+	}
+}
+
+// Close releases the underlying Redis connection.
+func (i *Inspector) Close() error {
+	return i.inspector.Close()
+}
+
+// Cancel signals the job with the given Asynq job ID to stop; the
+// corresponding context passed to the handler is cancelled. Implements
+// service.Canceller.
+func (i *Inspector) Cancel(jobID string) error {
+	return i.inspector.CancelProcessing(jobID)
+}
+
+// DeadLetterTask summarizes a job that exhausted its retries.
+type DeadLetterTask struct {
+	JobID     string    `json:"job_id"`
+	TaskID    string    `json:"task_id,omitempty"`
+	LastError string    `json:"last_error"`
+	FailedAt  time.Time `json:"failed_at"`
+}
+
+// ListDeadLetter returns every archived (exhausted-retry) job on the
+// configured queue.
+func (i *Inspector) ListDeadLetter() ([]DeadLetterTask, error) {
+	archived, err := i.inspector.ListArchivedTasks(i.queue)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]DeadLetterTask, 0, len(archived))
+	for _, a := range archived {
+		var payload ProcessTaskPayload
+		_ = payload.unmarshal(a.Payload)
+		out = append(out, DeadLetterTask{
+			JobID:     a.ID,
+			TaskID:    payload.TaskID,
+			LastError: a.LastErr,
+			FailedAt:  a.LastFailedAt,
+		})
+	}
+	return out, nil
+}
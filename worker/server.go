@@ -0,0 +1,25 @@
+package worker
+
+import (
+	"github.com/hibiken/asynq"
+
+	"go-demo/config"
+)
+
+// RunServer starts an Asynq server that processes TypeProcessTask jobs
+// with proc until the process receives a shutdown signal. It blocks for
+// the lifetime of the worker.
+func RunServer(cfg config.QueueConfig, proc *Processor) error {
+	srv := asynq.NewServer(
+		asynq.RedisClientOpt{Addr: cfg.RedisAddr},
+		asynq.Config{
+			Concurrency: cfg.Concurrency,
+			Queues:      map[string]int{cfg.Queue: 1},
+		},
+	)
+
+	mux := asynq.NewServeMux()
+	mux.HandleFunc(TypeProcessTask, proc.ProcessTask)
+
+	return srv.Run(mux)
+}
@@ -0,0 +1,22 @@
+// Package worker runs tasks asynchronously on an Asynq/Redis queue: it
+// enqueues "process task" jobs on task creation, processes them with
+// support for cancellation, and exposes retry/dead-letter inspection.
+package worker
+
+import "encoding/json"
+
+// TypeProcessTask is the Asynq task type for processing a single Task.
+const TypeProcessTask = "task:process"
+
+// ProcessTaskPayload is the Asynq task payload for TypeProcessTask.
+type ProcessTaskPayload struct {
+	TaskID string `json:"task_id"`
+}
+
+func (p ProcessTaskPayload) marshal() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+func (p *ProcessTaskPayload) unmarshal(data []byte) error {
+	return json.Unmarshal(data, p)
+}